@@ -0,0 +1,86 @@
+package money
+
+import (
+	"errors"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// ErrAllocateInvalidRatios indicates Allocate was called with no ratios or a negative ratio.
+var ErrAllocateInvalidRatios = errors.New("money: invalid allocation ratios")
+
+// Allocate splits m into len(ratios) parts proportional to ratios, distributing the minor-unit
+// remainder one unit at a time to the earliest parts so the parts always sum back to exactly m.
+// This is the standard "fair split a bill" algorithm (e.g. splitting $100 three ways as
+// $33.34/$33.33/$33.33 rather than losing a cent to rounding).
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+	if len(ratios) == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	var total int64
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrAllocateInvalidRatios
+		}
+		total += r
+	}
+	if total == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	results := make([]Money, len(ratios))
+	for i, r := range ratios {
+		share := m.PercentedOf(NewMoneyFromFraction(r, uint64(total), m.currency)) //nolint:gosec // total > 0 checked above
+		// PercentOf multiplies by the ratio Money's amount, i.e. m * (r/total).
+		results[i] = share
+	}
+
+	// Round every share down to the currency's natural integer scale, then hand out the
+	// remainder left over from truncation one minor unit at a time.
+	remainder := m
+	for i, share := range results {
+		rounded := share.Rounded(zerorat.RoundDown, MinorUnits(m.currency))
+		results[i] = rounded
+		if err := remainder.Sub(rounded); err != nil {
+			return nil, err
+		}
+	}
+
+	minorDenom := pow10(MinorUnits(m.currency))
+	unit := NewMoney(m.currency, zerorat.New(1, minorDenom))
+	if m.IsNegative() {
+		unit = NewMoney(m.currency, zerorat.New(-1, minorDenom))
+	}
+	for i := 0; !remainder.IsZero() && i < len(results); i++ {
+		results[i] = results[i].Added(unit)
+		remainder = remainder.Subtracted(unit)
+	}
+
+	return results, nil
+}
+
+// pow10 computes 10^n for small non-negative n, used to build minor-unit fractions like 1/100.
+func pow10(n int) uint64 {
+	result := uint64(1)
+	for i := 0; i < n; i++ {
+		result *= 10
+	}
+	return result
+}
+
+// Split divides m into n equal parts, distributing any remainder across the first parts.
+// Equivalent to Allocate with n equal ratios of 1.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
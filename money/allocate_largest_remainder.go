@@ -0,0 +1,85 @@
+package money
+
+import (
+	"sort"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// AllocateLargestRemainder splits m into len(ratios) parts like Allocate, but hands out the
+// minor-unit remainder to the parts with the largest truncated fraction first (the Hare-Niemeyer
+// apportionment method) instead of Allocate's fixed left-to-right order. Useful when the caller
+// wants the remainder distribution to track which shares were rounded down the most.
+func (m Money) AllocateLargestRemainder(ratios ...int64) ([]Money, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+	if len(ratios) == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	var total int64
+	for _, r := range ratios {
+		if r < 0 {
+			return nil, ErrAllocateInvalidRatios
+		}
+		total += r
+	}
+	if total == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	scale := MinorUnits(m.currency)
+	results := make([]Money, len(ratios))
+	fractions := make([]zerorat.Rat, len(ratios))
+	for i, r := range ratios {
+		share := m.PercentedOf(NewMoneyFromFraction(r, uint64(total), m.currency)) //nolint:gosec // total > 0 checked above
+		rounded := share.Rounded(zerorat.RoundDown, scale)
+		results[i] = rounded
+		fractions[i] = share.Subtracted(rounded).amount
+	}
+
+	remainder := m
+	for _, rounded := range results {
+		if err := remainder.Sub(rounded); err != nil {
+			return nil, err
+		}
+	}
+
+	order := make([]int, len(ratios))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return fractions[order[b]].Less(fractions[order[a]])
+	})
+
+	minorDenom := pow10(scale)
+	unit := NewMoney(m.currency, zerorat.New(1, minorDenom))
+	if m.IsNegative() {
+		unit = NewMoney(m.currency, zerorat.New(-1, minorDenom))
+	}
+	for _, i := range order {
+		if remainder.IsZero() {
+			break
+		}
+		results[i] = results[i].Added(unit)
+		remainder = remainder.Subtracted(unit)
+	}
+
+	return results, nil
+}
+
+// SplitLargestRemainder divides m into n equal parts like Split, but hands out the remainder
+// using AllocateLargestRemainder's largest-fraction-first order instead of Split's left-to-right
+// order.
+func (m Money) SplitLargestRemainder(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+	ratios := make([]int64, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.AllocateLargestRemainder(ratios...)
+}
@@ -0,0 +1,61 @@
+package money
+
+import "testing"
+
+func TestAllocateLargestRemainder(t *testing.T) {
+	m := NewMoneyFromFraction(1000, 100, "USD") // $10.00
+
+	parts, err := m.AllocateLargestRemainder(1, 1, 1)
+	if err != nil {
+		t.Fatalf("AllocateLargestRemainder returned error: %v", err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
+
+func TestSplitLargestRemainder(t *testing.T) {
+	m := NewMoneyFromFraction(1000, 100, "USD") // $10.00
+
+	parts, err := m.SplitLargestRemainder(3)
+	if err != nil {
+		t.Fatalf("SplitLargestRemainder returned error: %v", err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
+
+func TestSplitLargestRemainderInvalidN(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+	if _, err := m.SplitLargestRemainder(0); err == nil {
+		t.Fatal("expected error for n <= 0")
+	}
+}
+
+func TestAllocateLargestRemainderInvalidRatios(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+
+	if _, err := m.AllocateLargestRemainder(); err == nil {
+		t.Fatal("expected error for empty ratios")
+	}
+	if _, err := m.AllocateLargestRemainder(-1, 1); err == nil {
+		t.Fatal("expected error for negative ratio")
+	}
+}
@@ -0,0 +1,25 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// AllocateByPercent splits m according to percentages expressed as zerorat.Rat values that must
+// sum to exactly 100 (e.g. 60, 25, 15). This is a thin, stricter wrapper over AllocateRat for
+// callers who think in percentages rather than arbitrary weights.
+func (m Money) AllocateByPercent(percentages ...zerorat.Rat) ([]Money, error) {
+	if len(percentages) == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	sum := zerorat.Zero()
+	for _, p := range percentages {
+		if p.IsInvalid() || p.Sign() < 0 {
+			return nil, ErrAllocateInvalidRatios
+		}
+		sum.Add(p)
+	}
+	if !sum.Equal(zerorat.NewFromInt(percentDivisor)) {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	return m.AllocateRat(percentages...)
+}
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestAllocateByPercent(t *testing.T) {
+	m := NewMoneyFromFraction(10000, 100, "USD") // $100.00
+
+	parts, err := m.AllocateByPercent(zerorat.NewFromInt(60), zerorat.NewFromInt(25), zerorat.NewFromInt(15))
+	if err != nil {
+		t.Fatalf("AllocateByPercent returned error: %v", err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
+
+func TestAllocateByPercentMustSumTo100(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+	if _, err := m.AllocateByPercent(zerorat.NewFromInt(50), zerorat.NewFromInt(40)); err == nil {
+		t.Fatal("expected error for percentages not summing to 100")
+	}
+}
@@ -0,0 +1,58 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// AllocateRat splits m proportionally to ratios expressed directly as zerorat.Rat weights
+// (rather than integer ratios like Allocate), again distributing the truncation remainder one
+// minor unit at a time across the parts.
+func (m Money) AllocateRat(ratios ...zerorat.Rat) ([]Money, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+	if len(ratios) == 0 {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	total := zerorat.Zero()
+	for _, r := range ratios {
+		if r.IsInvalid() || r.Sign() < 0 {
+			return nil, ErrAllocateInvalidRatios
+		}
+		total.Add(r)
+	}
+	if total.IsZero() {
+		return nil, ErrAllocateInvalidRatios
+	}
+
+	results := make([]Money, len(ratios))
+	for i, r := range ratios {
+		weight := r
+		weight.Div(total)
+		share, err := m.MultipliedRatErr(weight)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = share
+	}
+
+	remainder := m
+	for i, share := range results {
+		rounded := share.Rounded(zerorat.RoundDown, MinorUnits(m.currency))
+		results[i] = rounded
+		if err := remainder.Sub(rounded); err != nil {
+			return nil, err
+		}
+	}
+
+	minorDenom := pow10(MinorUnits(m.currency))
+	unit := NewMoney(m.currency, zerorat.New(1, minorDenom))
+	if m.IsNegative() {
+		unit = NewMoney(m.currency, zerorat.New(-1, minorDenom))
+	}
+	for i := 0; !remainder.IsZero() && i < len(results); i++ {
+		results[i] = results[i].Added(unit)
+		remainder = remainder.Subtracted(unit)
+	}
+
+	return results, nil
+}
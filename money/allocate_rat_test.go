@@ -0,0 +1,35 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestAllocateRat(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+
+	parts, err := m.AllocateRat(zerorat.NewFromInt(1), zerorat.NewFromInt(2))
+	if err != nil {
+		t.Fatalf("AllocateRat returned error: %v", err)
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
+
+func TestAllocateRatInvalid(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+
+	if _, err := m.AllocateRat(); err == nil {
+		t.Fatal("expected error for no ratios")
+	}
+	if _, err := m.AllocateRat(zerorat.NewFromInt(-1)); err == nil {
+		t.Fatal("expected error for negative ratio")
+	}
+}
@@ -0,0 +1,51 @@
+package money
+
+import "testing"
+
+func TestAllocate(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+
+	parts, err := m.Allocate(1, 1, 1)
+	if err != nil {
+		t.Fatalf("Allocate returned error: %v", err)
+	}
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(parts))
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
+
+func TestAllocateInvalidRatios(t *testing.T) {
+	m := NewMoneyFromFraction(100, 1, "USD")
+
+	if _, err := m.Allocate(); err == nil {
+		t.Fatal("expected error for empty ratios")
+	}
+	if _, err := m.Allocate(-1, 1); err == nil {
+		t.Fatal("expected error for negative ratio")
+	}
+}
+
+func TestSplit(t *testing.T) {
+	m := NewMoneyFromFraction(10, 1, "USD")
+
+	parts, err := m.Split(3)
+	if err != nil {
+		t.Fatalf("Split returned error: %v", err)
+	}
+
+	sum, err := SumErr(parts...)
+	if err != nil {
+		t.Fatalf("SumErr returned error: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Fatalf("parts do not sum back to original: got %s, want %s", sum, m)
+	}
+}
@@ -0,0 +1,16 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// NewMoneyApproximated creates a Money from a float64 value using the best rational
+// approximation with a denominator no larger than maxDenom (zerorat.ApproximateFloat64), rather
+// than NewMoneyFloat's exact-bits conversion. Useful for values computed via floating-point
+// arithmetic upstream (e.g. a financial rate) where the exact float64 bit pattern isn't
+// meaningful but a clean bounded-denominator fraction is.
+func NewMoneyApproximated(currency Currency, value float64, maxDenom uint64) (Money, error) {
+	amount := zerorat.ApproximateFloat64(value, maxDenom)
+	if amount.IsInvalid() {
+		return Money{}, ErrMoneyInvalid
+	}
+	return NewMoneyErr(currency, amount)
+}
@@ -0,0 +1,20 @@
+package money
+
+import "testing"
+
+func TestNewMoneyApproximated(t *testing.T) {
+	got, err := NewMoneyApproximated("USD", 1.0/3.0, 100)
+	if err != nil {
+		t.Fatalf("NewMoneyApproximated: %v", err)
+	}
+	want := NewMoneyFromFraction(1, 3, "USD")
+	if !got.Equal(want) {
+		t.Errorf("NewMoneyApproximated() = %v, want %v", got, want)
+	}
+}
+
+func TestNewMoneyApproximatedEmptyCurrency(t *testing.T) {
+	if _, err := NewMoneyApproximated("", 1.5, 100); err == nil {
+		t.Fatal("expected error for empty currency")
+	}
+}
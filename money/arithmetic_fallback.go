@@ -0,0 +1,73 @@
+package money
+
+// AddFallback adds other to m the same as Add, except that on zerorat overflow it retries the
+// computation with math/big instead of invalidating (mutable operation), via
+// zerorat.Rat.AddFallback. Currency mismatch and invalid operands still invalidate, the same as
+// Add.
+func (m *Money) AddFallback(other Money) error {
+	if m.IsInvalid() || other.IsInvalid() {
+		m.Invalidate()
+		return ErrMoneyInvalid
+	}
+	if !m.SameCurrency(other) {
+		m.Invalidate()
+		return ErrMoneyCurrencyMismatch
+	}
+
+	m.amount.AddFallback(other.amount)
+	if m.amount.IsInvalid() {
+		m.Invalidate()
+		return ErrMoneyInvalid
+	}
+	return nil
+}
+
+// AddedFallbackErr returns the sum of m and other, falling back to math/big on overflow
+// (immutable operation with error).
+func (m Money) AddedFallbackErr(other Money) (Money, error) {
+	result := m
+	err := result.AddFallback(other)
+	return result, err
+}
+
+// AddedFallback returns the sum of m and other, falling back to math/big on overflow (immutable
+// operation without error). Returns invalid Money on error.
+func (m Money) AddedFallback(other Money) Money {
+	result, _ := m.AddedFallbackErr(other)
+	return result
+}
+
+// SubFallback subtracts other from m the same as Sub, falling back to math/big on overflow
+// (mutable operation); see AddFallback.
+func (m *Money) SubFallback(other Money) error {
+	if m.IsInvalid() || other.IsInvalid() {
+		m.Invalidate()
+		return ErrMoneyInvalid
+	}
+	if !m.SameCurrency(other) {
+		m.Invalidate()
+		return ErrMoneyCurrencyMismatch
+	}
+
+	m.amount.SubFallback(other.amount)
+	if m.amount.IsInvalid() {
+		m.Invalidate()
+		return ErrMoneyInvalid
+	}
+	return nil
+}
+
+// SubtractedFallbackErr returns m minus other, falling back to math/big on overflow (immutable
+// operation with error).
+func (m Money) SubtractedFallbackErr(other Money) (Money, error) {
+	result := m
+	err := result.SubFallback(other)
+	return result, err
+}
+
+// SubtractedFallback returns m minus other, falling back to math/big on overflow (immutable
+// operation without error). Returns invalid Money on error.
+func (m Money) SubtractedFallback(other Money) Money {
+	result, _ := m.SubtractedFallbackErr(other)
+	return result
+}
@@ -0,0 +1,33 @@
+package money
+
+import "testing"
+
+func TestMoneyAddedFallbackMatchesAddedWhenNoOverflow(t *testing.T) {
+	a := NewMoneyFromFraction(150, 100, "USD")
+	b := NewMoneyFromFraction(250, 100, "USD")
+
+	got := a.AddedFallback(b)
+	want := a.Added(b)
+	if !got.Equal(want) {
+		t.Errorf("AddedFallback() = %v, want %v", got, want)
+	}
+}
+
+func TestMoneyAddedFallbackCurrencyMismatch(t *testing.T) {
+	a := NewMoneyFromFraction(100, 100, "USD")
+	b := NewMoneyFromFraction(100, 100, "EUR")
+	if _, err := a.AddedFallbackErr(b); err == nil {
+		t.Fatal("expected currency mismatch error")
+	}
+}
+
+func TestMoneySubtractedFallbackMatchesSubtracted(t *testing.T) {
+	a := NewMoneyFromFraction(500, 100, "USD")
+	b := NewMoneyFromFraction(150, 100, "USD")
+
+	got := a.SubtractedFallback(b)
+	want := a.Subtracted(b)
+	if !got.Equal(want) {
+		t.Errorf("SubtractedFallback() = %v, want %v", got, want)
+	}
+}
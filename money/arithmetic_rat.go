@@ -28,6 +28,48 @@ func (m *Money) finalizeRatOperation() error {
 	return nil
 }
 
+// AddRatMany adds any number of zerorat.Rat values to this Money in one call (immutable
+// operation), short-circuiting on the first invalid operand or overflow.
+func (m Money) AddRatMany(values ...zerorat.Rat) (Money, error) {
+	result := m
+	for _, v := range values {
+		var err error
+		result, err = result.AddedRatErr(v)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
+// SubRatMany subtracts any number of zerorat.Rat values from this Money in one call (immutable
+// operation), short-circuiting on the first invalid operand or overflow.
+func (m Money) SubRatMany(values ...zerorat.Rat) (Money, error) {
+	result := m
+	for _, v := range values {
+		var err error
+		result, err = result.SubtractedRatErr(v)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
+// MulRatMany multiplies this Money by any number of zerorat.Rat values in one call (immutable
+// operation), short-circuiting on the first invalid operand or overflow.
+func (m Money) MulRatMany(values ...zerorat.Rat) (Money, error) {
+	result := m
+	for _, v := range values {
+		var err error
+		result, err = result.MultipliedRatErr(v)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
 // AddRat adds a zerorat.Rat value to this Money (mutable operation).
 // Sets invalid state on invalid operands or arithmetic overflow.
 // Uses pointer receiver for mutable operation.
@@ -850,12 +850,12 @@ func TestMoneyPercentOperations(t *testing.T) {
 		})
 	})
 
-	t.Run("PercentMoney operations", func(t *testing.T) {
-		t.Run("mutable PercentMoney - same currency success", func(t *testing.T) {
-			m1 := NewMoneyInt("USD", 200) // $2.00
-			m2 := NewMoneyInt("USD", 50)  // 50 (as percentage rate)
+	t.Run("PercentOf operations", func(t *testing.T) {
+		t.Run("mutable PercentOf - same currency success", func(t *testing.T) {
+			m1 := NewMoneyInt("USD", 200)           // 200
+			m2 := NewMoneyFromFraction(1, 2, "USD") // 0.5, as a proportion
 
-			err := m1.PercentMoney(m2) // $2.00 * (50 / 100) = $1.00
+			err := m1.PercentOf(m2) // 200 * 0.5 = 100
 
 			require.NoError(t, err)
 			assert.True(t, m1.IsValid())
@@ -863,22 +863,22 @@ func TestMoneyPercentOperations(t *testing.T) {
 			assert.True(t, m1.Equal(expected))
 		})
 
-		t.Run("mutable PercentMoney - different currency failure", func(t *testing.T) {
+		t.Run("mutable PercentOf - different currency failure", func(t *testing.T) {
 			m1 := NewMoneyInt("USD", 200)
 			m2 := NewMoneyInt("EUR", 50)
 
-			err := m1.PercentMoney(m2)
+			err := m1.PercentOf(m2)
 
 			require.Error(t, err)
 			assert.Equal(t, ErrMoneyCurrencyMismatch, err)
 			assert.True(t, m1.IsInvalid())
 		})
 
-		t.Run("immutable PercentMoneyErr - same currency success", func(t *testing.T) {
-			m1 := NewMoneyInt("USD", 400) // $4.00
-			m2 := NewMoneyInt("USD", 25)  // 25 (as percentage rate)
+		t.Run("immutable PercentOfErr - same currency success", func(t *testing.T) {
+			m1 := NewMoneyInt("USD", 400)           // 400
+			m2 := NewMoneyFromFraction(1, 4, "USD") // 0.25, as a proportion
 
-			result, err := m1.PercentMoneyErr(m2) // $4.00 * (25 / 100) = $1.00
+			result, err := m1.PercentOfErr(m2) // 400 * 0.25 = 100
 
 			require.NoError(t, err)
 			assert.True(t, result.IsValid())
@@ -889,25 +889,25 @@ func TestMoneyPercentOperations(t *testing.T) {
 			assert.True(t, m1.Equal(original))
 		})
 
-		t.Run("immutable PercentedMoney - same currency success", func(t *testing.T) {
-			m1 := NewMoneyInt("USD", 100) // $1.00
-			m2 := NewMoneyInt("USD", 50)  // 50 (as percentage rate)
+		t.Run("immutable PercentedOf - same currency success", func(t *testing.T) {
+			m1 := NewMoneyInt("USD", 100)           // 100
+			m2 := NewMoneyFromFraction(1, 2, "USD") // 0.5, as a proportion
 
-			result := m1.PercentedMoney(m2) // $1.00 * (50 / 100) = $0.50
+			result := m1.PercentedOf(m2) // 100 * 0.5 = 50
 
 			assert.True(t, result.IsValid())
-			expected := NewMoneyInt("USD", 50) // $1.00 * 0.5 = 50 cents
+			expected := NewMoneyInt("USD", 50)
 			assert.True(t, result.Equal(expected))
 			// Original unchanged
 			original := NewMoneyInt("USD", 100)
 			assert.True(t, m1.Equal(original))
 		})
 
-		t.Run("immutable PercentedMoney - different currency returns invalid", func(t *testing.T) {
+		t.Run("immutable PercentedOf - different currency returns invalid", func(t *testing.T) {
 			m1 := NewMoneyInt("USD", 100)
 			m2 := NewMoneyInt("EUR", 50)
 
-			result := m1.PercentedMoney(m2)
+			result := m1.PercentedOf(m2)
 
 			assert.True(t, result.IsInvalid())
 			// Original unchanged
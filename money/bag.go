@@ -0,0 +1,125 @@
+package money
+
+import "sort"
+
+// Bag holds a set of Money values across multiple currencies, keeping at most one entry per
+// currency and maintaining the entries sorted by currency code. This is the project's
+// multi-currency wallet type: arithmetic on a Bag never mixes currencies implicitly, it just
+// accumulates per-currency totals.
+type Bag struct {
+	entries map[Currency]Money
+}
+
+// NewBag creates an empty Bag.
+func NewBag() Bag {
+	return Bag{entries: make(map[Currency]Money)}
+}
+
+// Add adds money to the Bag, accumulating into any existing entry for the same currency
+// (mutable operation). Invalid Money is rejected with ErrMoneyInvalid and leaves the Bag unchanged.
+func (b *Bag) Add(m Money) error {
+	if m.IsInvalid() {
+		return ErrMoneyInvalid
+	}
+	if b.entries == nil {
+		b.entries = make(map[Currency]Money)
+	}
+
+	existing, ok := b.entries[m.Currency()]
+	if !ok {
+		b.entries[m.Currency()] = m
+		return nil
+	}
+
+	sum, err := existing.AddedErr(m)
+	if err != nil {
+		return err
+	}
+	b.entries[m.Currency()] = sum
+	return nil
+}
+
+// Get returns the Money held for currency, or an invalid Money if the Bag has no entry for it.
+func (b Bag) Get(currency Currency) Money {
+	if b.entries == nil {
+		return Money{}
+	}
+	return b.entries[currency]
+}
+
+// Currencies returns the currencies present in the Bag, sorted lexicographically. This sorted
+// invariant is what makes Bag.Equal and iteration order deterministic.
+func (b Bag) Currencies() []Currency {
+	currencies := make([]Currency, 0, len(b.entries))
+	for c := range b.entries {
+		currencies = append(currencies, c)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// Entries returns the Bag's Money values, sorted by currency code.
+func (b Bag) Entries() []Money {
+	currencies := b.Currencies()
+	result := make([]Money, 0, len(currencies))
+	for _, c := range currencies {
+		result = append(result, b.entries[c])
+	}
+	return result
+}
+
+// IsEmpty reports whether the Bag holds no currencies.
+func (b Bag) IsEmpty() bool {
+	return len(b.entries) == 0
+}
+
+// Wallet is an alias for Bag, kept for callers that prefer the more domain-specific name.
+type Wallet = Bag
+
+// NewWallet creates an empty Wallet (Bag).
+func NewWallet() Wallet {
+	return NewBag()
+}
+
+// Sub subtracts money from the Bag, creating a negative entry if the currency isn't already
+// present (mutable operation). Invalid Money is rejected.
+func (b *Bag) Sub(m Money) error {
+	if m.IsInvalid() {
+		return ErrMoneyInvalid
+	}
+	return b.Add(m.MultipliedInt(-1))
+}
+
+// Merge combines other into b, accumulating every currency entry (mutable operation).
+func (b *Bag) Merge(other Bag) error {
+	for _, m := range other.Entries() {
+		if err := b.Add(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Clone returns an independent copy of b: mutating the returned Bag (via Add/Sub/Merge) never
+// affects b, since Bag's entries map is otherwise shared by value-copy assignment.
+func (b Bag) Clone() Bag {
+	clone := NewBag()
+	for c, m := range b.entries {
+		clone.entries[c] = m
+	}
+	return clone
+}
+
+// Equal reports whether two Bags hold exactly the same set of currencies with equal amounts.
+func (b Bag) Equal(other Bag) bool {
+	if len(b.entries) != len(other.entries) {
+		return false
+	}
+	for c, m := range b.entries {
+		om, ok := other.entries[c]
+		if !ok || !m.Equal(om) {
+			return false
+		}
+	}
+	return true
+}
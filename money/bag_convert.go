@@ -0,0 +1,25 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// ConvertAll converts every entry in b into target using provider, summing the results into a
+// single Money total. Returns ErrMoneyInvalid for an empty Bag (nothing to convert, no currency
+// to anchor the result to) or whatever error the first failing Convert/sum step produces.
+func (b Bag) ConvertAll(target Currency, provider RateProvider) (Money, error) {
+	entries := b.Entries()
+	if len(entries) == 0 {
+		return Money{}, ErrMoneyInvalid
+	}
+
+	total := NewMoney(target, zerorat.New(0, 1))
+	for _, m := range entries {
+		converted, err := m.Convert(target, provider)
+		if err != nil {
+			return Money{}, err
+		}
+		if err := total.Add(converted); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,86 @@
+package money
+
+import "testing"
+
+func TestBagAddAccumulatesPerCurrency(t *testing.T) {
+	b := NewBag()
+	if err := b.Add(NewMoneyFromFraction(500, 100, "USD")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := b.Add(NewMoneyFromFraction(250, 100, "USD")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := b.Add(NewMoneyFromFraction(1000, 100, "EUR")); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	want := NewMoneyFromFraction(750, 100, "USD")
+	if got := b.Get("USD"); !got.Equal(want) {
+		t.Errorf("Get(USD) = %v, want %v", got, want)
+	}
+	if currencies := b.Currencies(); len(currencies) != 2 || currencies[0] != "EUR" || currencies[1] != "USD" {
+		t.Errorf("Currencies() = %v, want sorted [EUR USD]", currencies)
+	}
+}
+
+func TestBagSubAndMerge(t *testing.T) {
+	a := NewBag()
+	_ = a.Add(NewMoneyFromFraction(1000, 100, "USD"))
+	if err := a.Sub(NewMoneyFromFraction(300, 100, "USD")); err != nil {
+		t.Fatalf("Sub: %v", err)
+	}
+
+	b := NewBag()
+	_ = b.Add(NewMoneyFromFraction(200, 100, "USD"))
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+
+	want := NewMoneyFromFraction(900, 100, "USD")
+	if got := a.Get("USD"); !got.Equal(want) {
+		t.Errorf("after Sub+Merge, Get(USD) = %v, want %v", got, want)
+	}
+}
+
+func TestBagEqual(t *testing.T) {
+	a := NewBag()
+	_ = a.Add(NewMoneyFromFraction(100, 1, "USD"))
+	b := NewBag()
+	_ = b.Add(NewMoneyFromFraction(100, 1, "USD"))
+
+	if !a.Equal(b) {
+		t.Error("Equal() = false for identical Bags, want true")
+	}
+
+	_ = b.Add(NewMoneyFromFraction(1, 1, "EUR"))
+	if a.Equal(b) {
+		t.Error("Equal() = true for differing Bags, want false")
+	}
+}
+
+func TestBagCloneIsIndependent(t *testing.T) {
+	a := NewBag()
+	_ = a.Add(NewMoneyFromFraction(100, 1, "USD"))
+
+	clone := a.Clone()
+	_ = clone.Add(NewMoneyFromFraction(50, 1, "USD"))
+
+	if got := a.Get("USD"); !got.Equal(NewMoneyFromFraction(100, 1, "USD")) {
+		t.Errorf("original Bag mutated by clone: Get(USD) = %v, want 100", got)
+	}
+	if got := clone.Get("USD"); !got.Equal(NewMoneyFromFraction(150, 1, "USD")) {
+		t.Errorf("clone.Get(USD) = %v, want 150", got)
+	}
+}
+
+func TestBagIsEmpty(t *testing.T) {
+	b := NewBag()
+	if !b.IsEmpty() {
+		t.Error("IsEmpty() = false for a fresh Bag, want true")
+	}
+	_ = b.Add(NewMoneyFromFraction(1, 1, "USD"))
+	if b.IsEmpty() {
+		t.Error("IsEmpty() = true after Add, want false")
+	}
+}
@@ -0,0 +1,65 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// BigAmount is a Money-like value holding an arbitrary-precision minor-unit amount via
+// math/big.Int, for denominations GenericMoney[T]'s int32/int64 storage can't reach (e.g.
+// hyperinflated currencies or blockchain token amounts with 18+ decimal subunits). Unlike the
+// rest of this package, BigAmount allocates; use GenericMoney[T] or Money when the width fits.
+type BigAmount struct {
+	currency Currency
+	minor    *big.Int
+}
+
+// NewBigAmount creates a BigAmount from a minor-unit *big.Int amount. The value is copied so the
+// caller's big.Int can keep being mutated independently.
+func NewBigAmount(currency Currency, minor *big.Int) BigAmount {
+	return BigAmount{currency: currency, minor: new(big.Int).Set(minor)}
+}
+
+// Currency returns the currency code.
+func (a BigAmount) Currency() Currency {
+	return a.currency
+}
+
+// Minor returns a copy of the amount expressed in minor units.
+func (a BigAmount) Minor() *big.Int {
+	return new(big.Int).Set(a.minor)
+}
+
+// Add returns the sum of a and other (immutable operation). Returns an error on currency mismatch.
+func (a BigAmount) Add(other BigAmount) (BigAmount, error) {
+	if a.currency != other.currency {
+		return BigAmount{}, ErrMoneyCurrencyMismatch
+	}
+	return BigAmount{currency: a.currency, minor: new(big.Int).Add(a.minor, other.minor)}, nil
+}
+
+// Sub returns the difference of a and other (immutable operation). Returns an error on currency
+// mismatch.
+func (a BigAmount) Sub(other BigAmount) (BigAmount, error) {
+	if a.currency != other.currency {
+		return BigAmount{}, ErrMoneyCurrencyMismatch
+	}
+	return BigAmount{currency: a.currency, minor: new(big.Int).Sub(a.minor, other.minor)}, nil
+}
+
+// Cmp compares a and other, returning -1, 0, or +1, the same convention as big.Int.Cmp. Mismatched
+// currencies compare by currency code so BigAmount remains totally ordered for sorting.
+func (a BigAmount) Cmp(other BigAmount) int {
+	if a.currency != other.currency {
+		if a.currency < other.currency {
+			return -1
+		}
+		return 1
+	}
+	return a.minor.Cmp(other.minor)
+}
+
+// String renders the amount as "currency minor" for debugging.
+func (a BigAmount) String() string {
+	return fmt.Sprintf("%s %s", a.currency, a.minor.String())
+}
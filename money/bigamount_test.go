@@ -0,0 +1,61 @@
+package money
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBigAmountAddSub(t *testing.T) {
+	huge, _ := new(big.Int).SetString("100000000000000000000000000", 10)
+	a := NewBigAmount("XTK", huge)
+	one := NewBigAmount("XTK", big.NewInt(1))
+
+	sum, err := a.Add(one)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	want, _ := new(big.Int).SetString("100000000000000000000000001", 10)
+	if sum.Minor().Cmp(want) != 0 {
+		t.Errorf("Add() minor = %s, want %s", sum.Minor(), want)
+	}
+
+	back, err := sum.Sub(one)
+	if err != nil {
+		t.Fatalf("Sub: %v", err)
+	}
+	if back.Minor().Cmp(huge) != 0 {
+		t.Errorf("Sub() minor = %s, want %s", back.Minor(), huge)
+	}
+}
+
+func TestBigAmountCurrencyMismatch(t *testing.T) {
+	a := NewBigAmount("USD", big.NewInt(1))
+	b := NewBigAmount("EUR", big.NewInt(1))
+	if _, err := a.Add(b); err == nil {
+		t.Fatal("expected currency mismatch error")
+	}
+}
+
+func TestBigAmountCmp(t *testing.T) {
+	a := NewBigAmount("USD", big.NewInt(1))
+	b := NewBigAmount("USD", big.NewInt(2))
+	if a.Cmp(b) >= 0 {
+		t.Errorf("Cmp(a, b) = %d, want negative", a.Cmp(b))
+	}
+}
+
+func TestBigAmountMinorIsIndependentCopy(t *testing.T) {
+	original := big.NewInt(5)
+	a := NewBigAmount("USD", original)
+	original.SetInt64(999)
+
+	if a.Minor().Int64() != 5 {
+		t.Errorf("BigAmount.Minor() = %d, want 5 (unaffected by caller mutation)", a.Minor().Int64())
+	}
+
+	got := a.Minor()
+	got.SetInt64(42)
+	if a.Minor().Int64() != 5 {
+		t.Errorf("mutating returned Minor() leaked into BigAmount: got %d, want 5", a.Minor().Int64())
+	}
+}
@@ -0,0 +1,67 @@
+package money
+
+import (
+	"encoding/gob"
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding the currency as a length-prefixed
+// string followed by the amount's zerorat.Rat binary representation.
+func (m Money) MarshalBinary() ([]byte, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+
+	amountBytes, err := m.amount.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, 1+len(m.currency)+len(amountBytes))
+	buf = append(buf, byte(len(m.currency))) //nolint:gosec // currency codes are always short
+	buf = append(buf, m.currency...)
+	buf = append(buf, amountBytes...)
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the format written by MarshalBinary.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) < 1 {
+		return fmt.Errorf("money: binary data too short")
+	}
+	n := int(data[0])
+	if len(data) < 1+n {
+		return fmt.Errorf("money: binary data truncated")
+	}
+	currency := string(data[1 : 1+n])
+
+	var amount zerorat.Rat
+	if err := amount.UnmarshalBinary(data[1+n:]); err != nil {
+		return fmt.Errorf("money: decoding amount: %w", err)
+	}
+
+	parsed, err := NewMoneyErr(currency, amount)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by delegating to MarshalBinary.
+func (m Money) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder by delegating to UnmarshalBinary.
+func (m *Money) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
+
+// init ensures Money's concrete type is registered with encoding/gob so it can be
+// transmitted inside interface{}-typed fields.
+func init() {
+	gob.Register(Money{})
+}
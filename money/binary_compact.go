@@ -0,0 +1,41 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// CompactBinary encodes m the same as MarshalBinary, but using the amount's varint-based
+// AppendCompactBinary form instead of zerorat.Rat's fixed 16-byte MarshalBinary, so small
+// amounts (the common case) take far fewer bytes on the wire.
+func (m Money) CompactBinary() ([]byte, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+
+	buf := make([]byte, 0, 1+len(m.currency)+4)
+	buf = append(buf, byte(len(m.currency))) //nolint:gosec // currency codes are always short
+	buf = append(buf, m.currency...)
+	buf = m.amount.AppendCompactBinary(buf)
+	return buf, nil
+}
+
+// FromCompactBinary decodes a Money from the format written by CompactBinary.
+func FromCompactBinary(data []byte) (Money, error) {
+	if len(data) < 1 {
+		return Money{}, fmt.Errorf("money: compact binary data too short")
+	}
+	n := int(data[0])
+	if len(data) < 1+n {
+		return Money{}, fmt.Errorf("money: compact binary data truncated")
+	}
+	currency := string(data[1 : 1+n])
+
+	amount, consumed := zerorat.FromCompactBinary(data[1+n:])
+	if consumed == 0 {
+		return Money{}, fmt.Errorf("money: decoding compact amount: malformed varint")
+	}
+
+	return NewMoneyErr(currency, amount)
+}
@@ -0,0 +1,44 @@
+package money
+
+import "testing"
+
+func TestMoneyBinaryRoundTrip(t *testing.T) {
+	want := NewMoneyFromFraction(-1234, 100, "EUR")
+
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+
+	var got Money
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("binary round trip = %v, want %v", got, want)
+	}
+}
+
+func TestMoneyBinaryMarshalInvalid(t *testing.T) {
+	var m Money
+	if _, err := m.MarshalBinary(); err == nil {
+		t.Fatal("MarshalBinary on invalid Money: expected error")
+	}
+}
+
+func TestMoneyCompactBinaryRoundTrip(t *testing.T) {
+	want := NewMoneyFromFraction(99, 100, "JPY")
+
+	data, err := want.CompactBinary()
+	if err != nil {
+		t.Fatalf("CompactBinary: %v", err)
+	}
+
+	got, err := FromCompactBinary(data)
+	if err != nil {
+		t.Fatalf("FromCompactBinary: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("compact binary round trip = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,97 @@
+package money
+
+import (
+	"sort"
+	"strings"
+)
+
+// Coins is a sorted, denomination-deduplicated slice of Money, modeled on the Cosmos SDK's
+// sdk.Coins type: unlike Bag (a map keyed by currency), Coins is a plain slice invariant-checked
+// to stay sorted by currency with no zero or duplicate entries, which is the representation
+// on-chain ledgers typically serialize.
+type Coins []Money
+
+// NewCoins builds a Coins value from money, summing duplicate currencies, dropping zero entries,
+// and sorting by currency code, same as Cosmos SDK's sdk.NewCoins.
+func NewCoins(money ...Money) (Coins, error) {
+	bag := NewBag()
+	for _, m := range money {
+		if m.IsInvalid() {
+			return nil, ErrMoneyInvalid
+		}
+		if err := bag.Add(m); err != nil {
+			return nil, err
+		}
+	}
+
+	var coins Coins
+	for _, m := range bag.Entries() {
+		if !m.IsZero() {
+			coins = append(coins, m)
+		}
+	}
+	return coins, nil
+}
+
+// IsValid reports whether c is sorted by currency code with no zero or duplicate entries, the
+// invariant NewCoins maintains.
+func (c Coins) IsValid() bool {
+	for i, m := range c {
+		if m.IsInvalid() || m.IsZero() {
+			return false
+		}
+		if i > 0 && c[i-1].Currency() >= m.Currency() {
+			return false
+		}
+	}
+	return true
+}
+
+// AmountOf returns the amount held for currency, or an invalid Money if absent.
+func (c Coins) AmountOf(currency Currency) Money {
+	i := sort.Search(len(c), func(i int) bool { return c[i].Currency() >= currency })
+	if i < len(c) && c[i].Currency() == currency {
+		return c[i]
+	}
+	return Money{}
+}
+
+// Add returns c plus other, re-deriving the sorted/deduplicated/zero-stripped invariant.
+func (c Coins) Add(other Coins) (Coins, error) {
+	return NewCoins(append(append([]Money{}, c...), other...)...)
+}
+
+// Sub returns c minus other, re-deriving the sorted/deduplicated/zero-stripped invariant.
+// Subtracting more than c holds of a currency is an error, matching the Cosmos SDK convention
+// that Coins never represents a negative balance.
+func (c Coins) Sub(other Coins) (Coins, error) {
+	negated := make([]Money, len(other))
+	for i, m := range other {
+		negated[i] = NewMoney(m.Currency(), m.Amount().Negated())
+	}
+	result, err := c.Add(negated)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range result {
+		if m.Amount().IsNegative() {
+			return nil, ErrMoneyInvalid
+		}
+	}
+	return result, nil
+}
+
+// IsZero reports whether c holds no currencies (either empty or built from all-zero inputs,
+// which NewCoins already strips).
+func (c Coins) IsZero() bool {
+	return len(c) == 0
+}
+
+// String renders c as its entries' String() forms joined by "+", e.g. "EUR/5+USD/10".
+func (c Coins) String() string {
+	parts := make([]string, len(c))
+	for i, m := range c {
+		parts[i] = m.String()
+	}
+	return strings.Join(parts, "+")
+}
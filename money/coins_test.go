@@ -0,0 +1,57 @@
+package money
+
+import "testing"
+
+func TestCoinsAddAndAmountOf(t *testing.T) {
+	a, err := NewCoins(
+		NewMoneyFromFraction(1000, 100, "USD"),
+		NewMoneyFromFraction(500, 100, "EUR"),
+	)
+	if err != nil {
+		t.Fatalf("NewCoins: %v", err)
+	}
+	b, err := NewCoins(NewMoneyFromFraction(250, 100, "USD"))
+	if err != nil {
+		t.Fatalf("NewCoins: %v", err)
+	}
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if !sum.AmountOf("USD").Equal(NewMoneyFromFraction(1250, 100, "USD")) {
+		t.Errorf("AmountOf(USD) = %v, want 12.50", sum.AmountOf("USD"))
+	}
+	if !sum.IsValid() {
+		t.Error("expected sum to be valid")
+	}
+}
+
+func TestCoinsSub(t *testing.T) {
+	a, _ := NewCoins(NewMoneyFromFraction(1000, 100, "USD"))
+	b, _ := NewCoins(NewMoneyFromFraction(400, 100, "USD"))
+
+	diff, err := a.Sub(b)
+	if err != nil {
+		t.Fatalf("Sub: %v", err)
+	}
+	if !diff.AmountOf("USD").Equal(NewMoneyFromFraction(600, 100, "USD")) {
+		t.Errorf("AmountOf(USD) = %v, want 6.00", diff.AmountOf("USD"))
+	}
+}
+
+func TestCoinsSubBelowZeroErrors(t *testing.T) {
+	a, _ := NewCoins(NewMoneyFromFraction(100, 100, "USD"))
+	b, _ := NewCoins(NewMoneyFromFraction(200, 100, "USD"))
+
+	if _, err := a.Sub(b); err == nil {
+		t.Fatal("expected error when subtracting more than c holds")
+	}
+}
+
+func TestCoinsIsZero(t *testing.T) {
+	var c Coins
+	if !c.IsZero() {
+		t.Error("expected empty Coins to be zero")
+	}
+}
@@ -0,0 +1,27 @@
+package money
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrColonFormatInvalid indicates a string passed to ParseMoneyColon wasn't in "CUR:amount" form.
+var ErrColonFormatInvalid = errors.New("money: invalid \"currency:amount\" format")
+
+// ColonString renders m as "CUR:amount" using a plain decimal amount (e.g. "USD:12.34"), a more
+// human-friendly alternative to String()'s "CUR/numerator/denominator" form.
+func (m Money) ColonString() string {
+	if m.IsInvalid() {
+		return invalidMoneyString
+	}
+	return m.currency + ":" + m.amount.FloatString(MinorUnits(m.currency))
+}
+
+// ParseMoneyColon parses the "CUR:amount" form produced by ColonString, e.g. "USD:12.34".
+func ParseMoneyColon(s string) (Money, error) {
+	currency, amount, ok := strings.Cut(s, ":")
+	if !ok || currency == "" || amount == "" {
+		return Money{}, ErrColonFormatInvalid
+	}
+	return ParseDecimal(currency, amount)
+}
@@ -0,0 +1,68 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CurrencyInfo holds ISO 4217 metadata for a currency: its code, the number of minor-unit
+// decimal digits (e.g. 2 for USD cents, 0 for JPY, 3 for BHD), its display name, and its
+// conventional symbol (e.g. "$" for USD), which may be empty for currencies with no common symbol.
+type CurrencyInfo struct {
+	Code        Currency
+	MinorUnits  int
+	DisplayName string
+	Symbol      string
+}
+
+// currencyRegistry holds the built-in ISO 4217 currencies, keyed by uppercase code.
+var currencyRegistry = map[Currency]CurrencyInfo{
+	"USD": {Code: "USD", MinorUnits: 2, DisplayName: "US Dollar", Symbol: "$"},
+	"EUR": {Code: "EUR", MinorUnits: 2, DisplayName: "Euro", Symbol: "€"},
+	"GBP": {Code: "GBP", MinorUnits: 2, DisplayName: "British Pound", Symbol: "£"},
+	"JPY": {Code: "JPY", MinorUnits: 0, DisplayName: "Japanese Yen", Symbol: "¥"},
+	"CHF": {Code: "CHF", MinorUnits: 2, DisplayName: "Swiss Franc"},
+	"BHD": {Code: "BHD", MinorUnits: 3, DisplayName: "Bahraini Dinar"},
+	"KWD": {Code: "KWD", MinorUnits: 3, DisplayName: "Kuwaiti Dinar"},
+}
+
+// LookupCurrency returns the registered ISO 4217 metadata for code (case-insensitive).
+// The second return value is false if code is not registered.
+func LookupCurrency(code Currency) (CurrencyInfo, bool) {
+	info, ok := currencyRegistry[strings.ToUpper(code)]
+	return info, ok
+}
+
+// RegisterCurrency adds or overrides a currency in the registry, keyed by the uppercased code.
+// Useful for custom/crypto currencies not covered by the built-in ISO 4217 table.
+func RegisterCurrency(info CurrencyInfo) {
+	info.Code = strings.ToUpper(info.Code)
+	currencyRegistry[info.Code] = info
+}
+
+// MinorUnits returns the number of decimal digits used by currency's minor unit, or the
+// fallback default (2) if the currency is not registered.
+func MinorUnits(currency Currency) int {
+	if info, ok := LookupCurrency(currency); ok {
+		return info.MinorUnits
+	}
+	return defaultMinorUnits
+}
+
+// defaultMinorUnits is used for currencies absent from the registry (most fiat currencies use 2).
+const defaultMinorUnits = 2
+
+// IsRegisteredCurrency reports whether code is a known ISO 4217 (or custom-registered) currency.
+func IsRegisteredCurrency(code Currency) bool {
+	_, ok := LookupCurrency(code)
+	return ok
+}
+
+// ValidateCurrency checks that code is registered, returning an error naming the unknown code
+// otherwise. Intended for input validation at API boundaries.
+func ValidateCurrency(code Currency) error {
+	if !IsRegisteredCurrency(code) {
+		return fmt.Errorf("money: unregistered currency %q", code)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package money
+
+import "testing"
+
+func TestLookupCurrency(t *testing.T) {
+	info, ok := LookupCurrency("usd")
+	if !ok {
+		t.Fatal("LookupCurrency(\"usd\") not found")
+	}
+	if info.Code != "USD" || info.MinorUnits != 2 {
+		t.Errorf("LookupCurrency(\"usd\") = %+v, want Code=USD MinorUnits=2", info)
+	}
+
+	if _, ok := LookupCurrency("XYZ"); ok {
+		t.Error("LookupCurrency(\"XYZ\") found, want not found")
+	}
+}
+
+func TestRegisterCurrency(t *testing.T) {
+	RegisterCurrency(CurrencyInfo{Code: "XTS", MinorUnits: 4, DisplayName: "Test Currency"})
+	if MinorUnits("xts") != 4 {
+		t.Errorf("MinorUnits(\"xts\") = %d, want 4", MinorUnits("xts"))
+	}
+	if !IsRegisteredCurrency("XTS") {
+		t.Error("IsRegisteredCurrency(\"XTS\") = false, want true")
+	}
+}
+
+func TestMinorUnitsDefault(t *testing.T) {
+	if got := MinorUnits("ZZZ"); got != defaultMinorUnits {
+		t.Errorf("MinorUnits(\"ZZZ\") = %d, want default %d", got, defaultMinorUnits)
+	}
+}
+
+func TestValidateCurrency(t *testing.T) {
+	if err := ValidateCurrency("USD"); err != nil {
+		t.Errorf("ValidateCurrency(\"USD\") returned error: %v", err)
+	}
+	if err := ValidateCurrency("NOTACODE"); err == nil {
+		t.Error("ValidateCurrency(\"NOTACODE\") expected error, got nil")
+	}
+}
@@ -0,0 +1,32 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// NewMoneyFromDecimal creates a Money from a plain decimal string amount (e.g. "19.99"),
+// paired with currency. Returns an error if amount cannot be parsed or currency is empty.
+func NewMoneyFromDecimal(currency Currency, amount string) (Money, error) {
+	rat, err := zerorat.ParseRat(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("money: parsing decimal amount: %w", err)
+	}
+	return NewMoneyErr(currency, rat)
+}
+
+// ParseDecimal parses a plain decimal amount string for currency into a Money.
+// Equivalent to NewMoneyFromDecimal; provided under this name for call-site symmetry with Decimal().
+func ParseDecimal(currency Currency, amount string) (Money, error) {
+	return NewMoneyFromDecimal(currency, amount)
+}
+
+// Decimal renders m's amount as a fixed-point decimal string with the currency's registered
+// minor-unit precision (e.g. "19.99" for USD, "1900" for JPY).
+func (m Money) Decimal() (string, error) {
+	if m.IsInvalid() {
+		return "", ErrMoneyInvalid
+	}
+	return m.amount.FloatString(MinorUnits(m.currency)), nil
+}
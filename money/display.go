@@ -0,0 +1,30 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Display renders m using its currency's registered symbol when one is known
+// (e.g. "$1.50"), falling back to "CODE amount" (e.g. "XTS 1.50") otherwise.
+// Amounts are rounded to the currency's minor-unit precision, like Decimal.
+// Returns invalidMoneyString for invalid Money.
+func (m Money) Display() string {
+	if m.IsInvalid() {
+		return invalidMoneyString
+	}
+
+	decimal, err := m.Decimal()
+	if err != nil {
+		return invalidMoneyString
+	}
+
+	if info, ok := LookupCurrency(m.currency); ok && info.Symbol != "" {
+		if strings.HasPrefix(decimal, "-") {
+			return "-" + info.Symbol + decimal[1:]
+		}
+		return info.Symbol + decimal
+	}
+
+	return fmt.Sprintf("%s %s", m.currency, decimal)
+}
@@ -0,0 +1,31 @@
+package money
+
+import "testing"
+
+func TestMoneyDisplaySymbol(t *testing.T) {
+	m := NewMoneyFromFraction(150, 100, "USD")
+	if got, want := m.Display(), "$1.50"; got != want {
+		t.Errorf("Display() = %q, want %q", got, want)
+	}
+}
+
+func TestMoneyDisplayNegativeSymbol(t *testing.T) {
+	m := NewMoneyFromFraction(-150, 100, "USD")
+	if got, want := m.Display(), "-$1.50"; got != want {
+		t.Errorf("Display() = %q, want %q", got, want)
+	}
+}
+
+func TestMoneyDisplayFallsBackToCode(t *testing.T) {
+	m := NewMoneyFromFraction(150, 100, "CHF")
+	if got, want := m.Display(), "CHF 1.50"; got != want {
+		t.Errorf("Display() = %q, want %q", got, want)
+	}
+}
+
+func TestMoneyDisplayInvalid(t *testing.T) {
+	var m Money
+	if got, want := m.Display(), invalidMoneyString; got != want {
+		t.Errorf("Display() = %q, want %q", got, want)
+	}
+}
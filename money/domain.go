@@ -0,0 +1,82 @@
+package money
+
+import (
+	"errors"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// Error definitions for domain-checked Money operations.
+var (
+	// ErrMoneyOutOfRange indicates an amount falls outside a Domain's [Min, Max] bounds.
+	ErrMoneyOutOfRange = errors.New("money: amount out of domain range")
+
+	// ErrMoneyNotQuantized indicates an amount is not an exact multiple of a Domain's Step.
+	ErrMoneyNotQuantized = errors.New("money: amount is not a multiple of domain step")
+)
+
+// Domain describes the bounds a currency's amount must satisfy: an inclusive [Min, Max] range
+// and, optionally, the smallest allowed increment (Step) between representable values, e.g. one
+// satoshi for BTC or one cent for USD. A zero-value Step means any quantization is allowed.
+type Domain struct {
+	Min  zerorat.Rat
+	Max  zerorat.Rat
+	Step zerorat.Rat // zero value (invalid Rat) disables quantization checking
+}
+
+// USDCentsDomain bounds an amount to non-negative whole cents, matching the precision a typical
+// fiat ledger can hold.
+var USDCentsDomain = Domain{
+	Min:  zerorat.Zero(),
+	Max:  zerorat.New(1<<62, 1),
+	Step: zerorat.New(1, 100),
+}
+
+// btcMaxMoney is Bitcoin's MAX_MONEY constant: 21 million BTC expressed in satoshis
+// (21_000_000 * 10^8).
+const btcMaxMoney = 21_000_000 * 100_000_000
+
+// BTCSatoshiDomain bounds an amount to non-negative whole satoshis, up to Bitcoin's MAX_MONEY
+// supply cap.
+var BTCSatoshiDomain = Domain{
+	Min:  zerorat.Zero(),
+	Max:  zerorat.New(btcMaxMoney, 100_000_000),
+	Step: zerorat.New(1, 100_000_000),
+}
+
+// InDomain reports whether m's amount satisfies d's bounds and quantization. Returns false for
+// an invalid Money.
+func (m Money) InDomain(d Domain) bool {
+	if m.IsInvalid() {
+		return false
+	}
+	if m.amount.Less(d.Min) || d.Max.Less(m.amount) {
+		return false
+	}
+	if d.Step.IsValid() && !d.Step.IsZero() {
+		quotient := m.amount.Divided(d.Step)
+		if !quotient.IsInteger() {
+			return false
+		}
+	}
+	return true
+}
+
+// NewMoneyChecked creates a Money from currency and amount, rejecting it with a typed error if it
+// falls outside d's bounds or isn't an exact multiple of d's step.
+func NewMoneyChecked(currency Currency, amount zerorat.Rat, d Domain) (Money, error) {
+	m, err := NewMoneyErr(currency, amount)
+	if err != nil {
+		return Money{}, err
+	}
+	if m.amount.Less(d.Min) || d.Max.Less(m.amount) {
+		return Money{}, ErrMoneyOutOfRange
+	}
+	if d.Step.IsValid() && !d.Step.IsZero() {
+		quotient := m.amount.Divided(d.Step)
+		if !quotient.IsInteger() {
+			return Money{}, ErrMoneyNotQuantized
+		}
+	}
+	return m, nil
+}
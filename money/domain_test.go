@@ -0,0 +1,40 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestNewMoneyCheckedWithinDomain(t *testing.T) {
+	m, err := NewMoneyChecked("USD", zerorat.New(199, 100), USDCentsDomain)
+	if err != nil {
+		t.Fatalf("NewMoneyChecked: %v", err)
+	}
+	if !m.InDomain(USDCentsDomain) {
+		t.Error("InDomain() = false, want true")
+	}
+}
+
+func TestNewMoneyCheckedRejectsOutOfRange(t *testing.T) {
+	_, err := NewMoneyChecked("USD", zerorat.New(-1, 100), USDCentsDomain)
+	if !errors.Is(err, ErrMoneyOutOfRange) {
+		t.Errorf("NewMoneyChecked: got %v, want ErrMoneyOutOfRange", err)
+	}
+}
+
+func TestNewMoneyCheckedRejectsUnquantized(t *testing.T) {
+	_, err := NewMoneyChecked("USD", zerorat.New(1, 1000), USDCentsDomain)
+	if !errors.Is(err, ErrMoneyNotQuantized) {
+		t.Errorf("NewMoneyChecked: got %v, want ErrMoneyNotQuantized", err)
+	}
+}
+
+func TestBTCSatoshiDomainRejectsAboveMaxMoney(t *testing.T) {
+	overMax := zerorat.New(btcMaxMoney+1, 100_000_000)
+	_, err := NewMoneyChecked("BTC", overMax, BTCSatoshiDomain)
+	if !errors.Is(err, ErrMoneyOutOfRange) {
+		t.Errorf("NewMoneyChecked: got %v, want ErrMoneyOutOfRange", err)
+	}
+}
@@ -0,0 +1,41 @@
+package money
+
+// MarshalText implements encoding.TextMarshaler using the same "currency/amount" format as String().
+func (m Money) MarshalText() ([]byte, error) {
+	if m.IsInvalid() {
+		return nil, ErrMoneyInvalid
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMoney.
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Money as a JSON string.
+func (m Money) MarshalJSON() ([]byte, error) {
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	quoted := make([]byte, 0, len(text)+2)
+	quoted = append(quoted, '"')
+	quoted = append(quoted, text...)
+	quoted = append(quoted, '"')
+	return quoted, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the quoted-string format produced by MarshalJSON.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return m.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestMoneyTextRoundTrip(t *testing.T) {
+	want := NewMoney("USD", zerorat.New(5, 4))
+
+	text, err := want.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+
+	var got Money
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%q): %v", text, err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("text round trip = %v, want %v", got, want)
+	}
+}
+
+func TestMoneyTextMarshalInvalid(t *testing.T) {
+	var m Money
+	if _, err := m.MarshalText(); err == nil {
+		t.Fatal("MarshalText on invalid Money: expected error")
+	}
+}
+
+func TestMoneyJSONRoundTrip(t *testing.T) {
+	want := NewMoneyFromFraction(1234, 100, "USD")
+
+	data, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+
+	var got Money
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s): %v", data, err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("JSON round trip = %v, want %v", got, want)
+	}
+}
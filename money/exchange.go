@@ -0,0 +1,69 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// RateProvider supplies exchange rates between currency pairs. Implementations are free to
+// back this with a live feed, a cache, or a fixed table; Convert only depends on this interface.
+type RateProvider interface {
+	// Rate returns the multiplier to convert 1 unit of `from` into `to` (to = from * rate).
+	// The second return value is false if no rate is available for the pair.
+	Rate(from, to Currency) (zerorat.Rat, bool)
+}
+
+// ErrNoExchangeRate indicates the RateProvider has no rate for the requested currency pair.
+var ErrNoExchangeRate = fmt.Errorf("money: no exchange rate available")
+
+// Convert converts m into targetCurrency using the rate supplied by provider.
+// Returns m unchanged if it is already in targetCurrency.
+func (m Money) Convert(targetCurrency Currency, provider RateProvider) (Money, error) {
+	if m.IsInvalid() {
+		return Money{}, ErrMoneyInvalid
+	}
+	if m.currency == targetCurrency {
+		return m, nil
+	}
+
+	rate, ok := provider.Rate(m.currency, targetCurrency)
+	if !ok || rate.IsInvalid() {
+		return Money{}, ErrNoExchangeRate
+	}
+
+	converted := m.amount
+	converted.Mul(rate)
+	return NewMoneyErr(targetCurrency, converted)
+}
+
+// FixedRateProvider is a RateProvider backed by a static in-memory table, keyed by
+// "FROM->TO" currency pairs. Useful for tests and for applications with infrequently
+// changing rates.
+type FixedRateProvider struct {
+	rates map[[2]Currency]zerorat.Rat
+}
+
+// NewFixedRateProvider creates an empty FixedRateProvider.
+func NewFixedRateProvider() *FixedRateProvider {
+	return &FixedRateProvider{rates: make(map[[2]Currency]zerorat.Rat)}
+}
+
+// SetRate registers the multiplier to convert 1 unit of from into to, and automatically
+// registers the inverse rate for the reverse direction.
+func (p *FixedRateProvider) SetRate(from, to Currency, rate zerorat.Rat) {
+	if p.rates == nil {
+		p.rates = make(map[[2]Currency]zerorat.Rat)
+	}
+	p.rates[[2]Currency{from, to}] = rate
+
+	inverse := zerorat.One()
+	inverse.Div(rate)
+	p.rates[[2]Currency{to, from}] = inverse
+}
+
+// Rate implements RateProvider.
+func (p *FixedRateProvider) Rate(from, to Currency) (zerorat.Rat, bool) {
+	rate, ok := p.rates[[2]Currency{from, to}]
+	return rate, ok
+}
@@ -0,0 +1,92 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// ExchangeRate is a named, serializable exchange rate quote between two currencies: one unit of
+// From converts to Rate units of To. Unlike RateProvider (an interface for looking rates up),
+// ExchangeRate is a plain value suitable for logging, storage, or building a RateProvider from a
+// list of quotes via NewFixedRateProviderFromRates.
+type ExchangeRate struct {
+	From Currency
+	To   Currency
+	Rate zerorat.Rat
+}
+
+// NewExchangeRate creates an ExchangeRate converting from into to at rate.
+// Returns ErrMoneyInvalid if from or to is empty, or ErrNoExchangeRate if rate is invalid.
+func NewExchangeRate(from, to Currency, rate zerorat.Rat) (ExchangeRate, error) {
+	if from == "" || to == "" {
+		return ExchangeRate{}, ErrMoneyInvalid
+	}
+	if rate.IsInvalid() {
+		return ExchangeRate{}, ErrNoExchangeRate
+	}
+	return ExchangeRate{From: from, To: to, Rate: rate}, nil
+}
+
+// NewExchangeRateFromFloat creates an ExchangeRate from a float64 rate.
+// Equivalent to NewExchangeRate(from, to, zerorat.NewFromFloat64(rate)).
+func NewExchangeRateFromFloat(from, to Currency, rate float64) (ExchangeRate, error) {
+	return NewExchangeRate(from, to, zerorat.NewFromFloat64(rate))
+}
+
+// NewExchangeRateFromFraction creates an ExchangeRate from a rate expressed as
+// numerator/denominator. Equivalent to NewExchangeRate(from, to, zerorat.New(numerator, denominator)).
+func NewExchangeRateFromFraction(from, to Currency, numerator int64, denominator uint64) (ExchangeRate, error) {
+	return NewExchangeRate(from, to, zerorat.New(numerator, denominator))
+}
+
+// Convert applies r to amount, converting it from r.From into r.To.
+// Returns an error if amount's currency doesn't match r.From or r.Rate is invalid.
+func (r ExchangeRate) Convert(amount Money) (Money, error) {
+	if amount.IsInvalid() {
+		return Money{}, ErrMoneyInvalid
+	}
+	if amount.currency != r.From {
+		return Money{}, fmt.Errorf("money: ExchangeRate is for %s, got %s", r.From, amount.currency)
+	}
+	if r.Rate.IsInvalid() {
+		return Money{}, ErrNoExchangeRate
+	}
+
+	converted := amount.amount
+	converted.Mul(r.Rate)
+	return NewMoneyErr(r.To, converted)
+}
+
+// Inverse returns the reciprocal rate, converting r.To back into r.From.
+func (r ExchangeRate) Inverse() ExchangeRate {
+	inverse := zerorat.One()
+	inverse.Div(r.Rate)
+	return ExchangeRate{From: r.To, To: r.From, Rate: inverse}
+}
+
+// Chain composes r with next into a single rate that converts r.From directly into next.To, by
+// multiplying the two rates (e.g. USD->EUR chained with EUR->GBP yields USD->GBP). Requires
+// r.To == next.From, and both rates to be valid.
+func (r ExchangeRate) Chain(next ExchangeRate) (ExchangeRate, error) {
+	if r.To != next.From {
+		return ExchangeRate{}, fmt.Errorf("money: cannot chain %s->%s with %s->%s", r.From, r.To, next.From, next.To)
+	}
+	if r.Rate.IsInvalid() || next.Rate.IsInvalid() {
+		return ExchangeRate{}, ErrNoExchangeRate
+	}
+
+	combined := r.Rate
+	combined.Mul(next.Rate)
+	return ExchangeRate{From: r.From, To: next.To, Rate: combined}, nil
+}
+
+// NewFixedRateProviderFromRates builds a FixedRateProvider preloaded with rates, registering each
+// pair's inverse automatically (see FixedRateProvider.SetRate).
+func NewFixedRateProviderFromRates(rates ...ExchangeRate) *FixedRateProvider {
+	provider := NewFixedRateProvider()
+	for _, r := range rates {
+		provider.SetRate(r.From, r.To, r.Rate)
+	}
+	return provider
+}
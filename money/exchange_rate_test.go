@@ -0,0 +1,127 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestExchangeRateConvert(t *testing.T) {
+	rate := ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(9, 10)}
+
+	got, err := rate.Convert(NewMoneyFromFraction(100, 1, "USD"))
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	want := NewMoneyFromFraction(90, 1, "EUR")
+	if !got.Equal(want) {
+		t.Errorf("Convert() = %v, want %v", got, want)
+	}
+}
+
+func TestExchangeRateConvertCurrencyMismatch(t *testing.T) {
+	rate := ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(9, 10)}
+	if _, err := rate.Convert(NewMoneyFromFraction(100, 1, "GBP")); err == nil {
+		t.Fatal("Convert with mismatched currency: expected error")
+	}
+}
+
+func TestExchangeRateInverse(t *testing.T) {
+	rate := ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(1, 2)}
+	inverse := rate.Inverse()
+	if inverse.From != "EUR" || inverse.To != "USD" {
+		t.Fatalf("Inverse() pair = %s->%s, want EUR->USD", inverse.From, inverse.To)
+	}
+	if !inverse.Rate.Equal(zerorat.New(2, 1)) {
+		t.Errorf("Inverse() rate = %v, want 2", inverse.Rate)
+	}
+}
+
+func TestNewExchangeRate(t *testing.T) {
+	rate, err := NewExchangeRate("USD", "EUR", zerorat.New(9, 10))
+	if err != nil {
+		t.Fatalf("NewExchangeRate: %v", err)
+	}
+	if rate.From != "USD" || rate.To != "EUR" || !rate.Rate.Equal(zerorat.New(9, 10)) {
+		t.Errorf("NewExchangeRate() = %+v, want USD->EUR at 9/10", rate)
+	}
+
+	if _, err := NewExchangeRate("", "EUR", zerorat.New(9, 10)); err == nil {
+		t.Error("NewExchangeRate with empty from: expected error")
+	}
+}
+
+func TestNewExchangeRateFromFloat(t *testing.T) {
+	rate, err := NewExchangeRateFromFloat("USD", "EUR", 0.9)
+	if err != nil {
+		t.Fatalf("NewExchangeRateFromFloat: %v", err)
+	}
+	if !rate.Rate.Equal(zerorat.NewFromFloat64(0.9)) {
+		t.Errorf("NewExchangeRateFromFloat() rate = %v, want NewFromFloat64(0.9)", rate.Rate)
+	}
+}
+
+func TestNewExchangeRateFromFraction(t *testing.T) {
+	rate, err := NewExchangeRateFromFraction("USD", "EUR", 9, 10)
+	if err != nil {
+		t.Fatalf("NewExchangeRateFromFraction: %v", err)
+	}
+	if !rate.Rate.Equal(zerorat.New(9, 10)) {
+		t.Errorf("NewExchangeRateFromFraction() rate = %v, want 9/10", rate.Rate)
+	}
+
+	if _, err := NewExchangeRateFromFraction("USD", "EUR", 9, 0); err == nil {
+		t.Error("NewExchangeRateFromFraction with zero denominator: expected error")
+	}
+}
+
+func TestExchangeRateChain(t *testing.T) {
+	usdToEur := ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(9, 10)}
+	eurToGbp := ExchangeRate{From: "EUR", To: "GBP", Rate: zerorat.New(85, 100)}
+
+	usdToGbp, err := usdToEur.Chain(eurToGbp)
+	if err != nil {
+		t.Fatalf("Chain: %v", err)
+	}
+	if usdToGbp.From != "USD" || usdToGbp.To != "GBP" {
+		t.Fatalf("Chain() pair = %s->%s, want USD->GBP", usdToGbp.From, usdToGbp.To)
+	}
+	want := zerorat.New(9, 10)
+	want.Mul(zerorat.New(85, 100))
+	if !usdToGbp.Rate.Equal(want) {
+		t.Errorf("Chain() rate = %v, want %v", usdToGbp.Rate, want)
+	}
+}
+
+func TestExchangeRateChainMismatch(t *testing.T) {
+	usdToEur := ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(9, 10)}
+	gbpToJpy := ExchangeRate{From: "GBP", To: "JPY", Rate: zerorat.New(150, 1)}
+
+	if _, err := usdToEur.Chain(gbpToJpy); err == nil {
+		t.Fatal("Chain with mismatched currencies: expected error")
+	}
+}
+
+func TestNewFixedRateProviderFromRates(t *testing.T) {
+	provider := NewFixedRateProviderFromRates(
+		ExchangeRate{From: "USD", To: "EUR", Rate: zerorat.New(9, 10)},
+	)
+
+	m := NewMoneyFromFraction(100, 1, "USD")
+	converted, err := m.Convert("EUR", provider)
+	if err != nil {
+		t.Fatalf("Convert: %v", err)
+	}
+	if !converted.Equal(NewMoneyFromFraction(90, 1, "EUR")) {
+		t.Errorf("Convert() = %v, want 90 EUR", converted)
+	}
+
+	// The reverse rate should have been registered automatically.
+	back, err := converted.Convert("USD", provider)
+	if err != nil {
+		t.Fatalf("Convert back: %v", err)
+	}
+	if !back.Equal(m) {
+		t.Errorf("Convert back = %v, want %v", back, m)
+	}
+}
@@ -0,0 +1,44 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// TriangulatedRateProvider wraps another RateProvider and falls back to triangulating through a
+// base currency (e.g. USD) when no direct rate is registered for a pair, computing
+// rate(from,to) = rate(from,base) * rate(base,to).
+type TriangulatedRateProvider struct {
+	Base  Currency
+	Inner RateProvider
+}
+
+// NewTriangulatedRateProvider creates a TriangulatedRateProvider that falls back to triangulating
+// through base when inner has no direct rate for a pair.
+func NewTriangulatedRateProvider(base Currency, inner RateProvider) *TriangulatedRateProvider {
+	return &TriangulatedRateProvider{Base: base, Inner: inner}
+}
+
+// Rate implements RateProvider, preferring a direct rate from Inner and triangulating through
+// Base only when the direct lookup fails.
+func (p *TriangulatedRateProvider) Rate(from, to Currency) (zerorat.Rat, bool) {
+	if rate, ok := p.Inner.Rate(from, to); ok {
+		return rate, ok
+	}
+	if from == p.Base || to == p.Base {
+		return zerorat.Rat{}, false
+	}
+
+	toBase, ok := p.Inner.Rate(from, p.Base)
+	if !ok {
+		return zerorat.Rat{}, false
+	}
+	fromBase, ok := p.Inner.Rate(p.Base, to)
+	if !ok {
+		return zerorat.Rat{}, false
+	}
+
+	rate := toBase
+	rate.Mul(fromBase)
+	if rate.IsInvalid() {
+		return zerorat.Rat{}, false
+	}
+	return rate, true
+}
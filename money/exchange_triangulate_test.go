@@ -0,0 +1,42 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestTriangulatedRateProviderFallsBackThroughBase(t *testing.T) {
+	fixed := NewFixedRateProvider()
+	fixed.SetRate("EUR", "USD", zerorat.New(11, 10))
+	fixed.SetRate("USD", "GBP", zerorat.New(4, 5))
+
+	provider := NewTriangulatedRateProvider("USD", fixed)
+
+	rate, ok := provider.Rate("EUR", "GBP")
+	if !ok {
+		t.Fatal("Rate(EUR, GBP) not found via triangulation")
+	}
+	want := zerorat.New(11, 10)
+	want.Mul(zerorat.New(4, 5))
+	if !rate.Equal(want) {
+		t.Errorf("Rate(EUR, GBP) = %v, want %v", rate, want)
+	}
+}
+
+func TestTriangulatedRateProviderPrefersDirectRate(t *testing.T) {
+	fixed := NewFixedRateProvider()
+	fixed.SetRate("EUR", "GBP", zerorat.New(9, 10))
+	fixed.SetRate("EUR", "USD", zerorat.New(11, 10))
+	fixed.SetRate("USD", "GBP", zerorat.New(4, 5))
+
+	provider := NewTriangulatedRateProvider("USD", fixed)
+
+	rate, ok := provider.Rate("EUR", "GBP")
+	if !ok {
+		t.Fatal("Rate(EUR, GBP) not found")
+	}
+	if !rate.Equal(zerorat.New(9, 10)) {
+		t.Errorf("Rate(EUR, GBP) = %v, want direct rate 9/10", rate)
+	}
+}
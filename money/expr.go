@@ -0,0 +1,396 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// ErrExprSyntax indicates that an expression string passed to EvaluateExpr is malformed.
+var ErrExprSyntax = fmt.Errorf("money: invalid expression syntax")
+
+// ErrExprSendNotSupported indicates that an expression used the "send X from A to B"
+// account-transfer construct, which has no representation in this package's account-free
+// Money/Bag model. It wraps ErrExprSyntax so callers matching on that still catch it.
+var ErrExprSendNotSupported = fmt.Errorf("%w: \"send ... from ... to ...\" is not supported", ErrExprSyntax)
+
+// EvaluateExpr evaluates a small numscript-style arithmetic expression over a single currency,
+// e.g. "USD 10.50 + USD 2.25 * 2 - (USD 1 / 4)". Money literals are written as "CUR amount"
+// (parsed with ParseMoneyDecimal) and may be negated ("EUR -3", "-USD 5"); bare numbers are
+// scalar multipliers/divisors and may also be negative. "+" and "-" require both operands to
+// already be Money of the same currency (use EvaluateExprBag to combine different currencies);
+// "*" and "/" require exactly one Money operand and one scalar. "N% of X" applies a percentage
+// to a Money operand (e.g. "15% of USD 50"). Operator precedence follows the usual rules (*, /
+// and % of bind tighter than +, -), and parentheses may be used to override it. The
+// "send X from A to B" account-transfer construct has no representation in this package's
+// account-free Money/Bag model and is not supported; it is rejected with ErrExprSendNotSupported
+// rather than silently misevaluated.
+func EvaluateExpr(expr string) (Money, error) {
+	result, err := evaluateExprValue(expr, false)
+	if err != nil {
+		return Money{}, err
+	}
+	return result.money()
+}
+
+// EvaluateExprBag evaluates the same grammar as EvaluateExpr but allows "+" and "-" to combine
+// Money of different currencies instead of failing with ErrMoneyCurrencyMismatch, accumulating
+// the result into a Bag. A single-currency expression still evaluates successfully, returning a
+// Bag with exactly one entry.
+func EvaluateExprBag(expr string) (Bag, error) {
+	result, err := evaluateExprValue(expr, true)
+	if err != nil {
+		return Bag{}, err
+	}
+	return result.bag()
+}
+
+func evaluateExprValue(expr string, allowBag bool) (exprValue, error) {
+	tokens, err := tokenizeExpr(expr)
+	if err != nil {
+		return exprValue{}, err
+	}
+	if len(tokens) > 0 && tokens[0] == "send" {
+		return exprValue{}, ErrExprSendNotSupported
+	}
+	p := &exprParser{tokens: tokens, allowBag: allowBag}
+	result, err := p.parseSum()
+	if err != nil {
+		return exprValue{}, err
+	}
+	if p.pos != len(p.tokens) {
+		return exprValue{}, fmt.Errorf("%w: unexpected token %q", ErrExprSyntax, p.tokens[p.pos])
+	}
+	return result, nil
+}
+
+// exprValue is an intermediate evaluation result: a Money amount, a Bag of mixed-currency
+// amounts, or a bare scalar Rat. "*" and "/" need to distinguish which operand is the scale
+// factor, and "+"/"-" need to distinguish single-currency (Money) from mixed-currency (Bag).
+type exprValue struct {
+	m        Money
+	b        Bag
+	scalar   zerorat.Rat
+	isMoney  bool
+	isBag    bool
+	isScalar bool
+}
+
+func (v exprValue) money() (Money, error) {
+	if !v.isMoney {
+		return Money{}, fmt.Errorf("%w: expression does not resolve to a Money value", ErrExprSyntax)
+	}
+	return v.m, nil
+}
+
+func (v exprValue) bag() (Bag, error) {
+	switch {
+	case v.isBag:
+		return v.b, nil
+	case v.isMoney:
+		result := NewBag()
+		if err := result.Add(v.m); err != nil {
+			return Bag{}, err
+		}
+		return result, nil
+	default:
+		return Bag{}, fmt.Errorf("%w: expression does not resolve to a Money or Bag value", ErrExprSyntax)
+	}
+}
+
+func (v exprValue) negated() (exprValue, error) {
+	switch {
+	case v.isMoney:
+		result, err := v.m.MultipliedIntErr(-1)
+		if err != nil {
+			return exprValue{}, err
+		}
+		return exprValue{m: result, isMoney: true}, nil
+	case v.isScalar:
+		neg := v.scalar
+		neg.MulInt(-1)
+		return exprValue{scalar: neg, isScalar: true}, nil
+	case v.isBag:
+		result := NewBag()
+		for _, m := range v.b.Entries() {
+			negM, err := m.MultipliedIntErr(-1)
+			if err != nil {
+				return exprValue{}, err
+			}
+			if err := result.Add(negM); err != nil {
+				return exprValue{}, err
+			}
+		}
+		return exprValue{b: result, isBag: true}, nil
+	default:
+		return exprValue{}, fmt.Errorf("%w: nothing to negate", ErrExprSyntax)
+	}
+}
+
+type exprParser struct {
+	tokens   []string
+	pos      int
+	allowBag bool
+}
+
+func (p *exprParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) parseSum() (exprValue, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return exprValue{}, err
+	}
+	for p.peek() == "+" || p.peek() == "-" {
+		op := p.tokens[p.pos]
+		p.pos++
+		right, err := p.parseProduct()
+		if err != nil {
+			return exprValue{}, err
+		}
+		left, err = combineAddSub(left, right, op, p.allowBag)
+		if err != nil {
+			return exprValue{}, err
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseProduct() (exprValue, error) {
+	left, err := p.parseAtom()
+	if err != nil {
+		return exprValue{}, err
+	}
+	for p.peek() == "*" || p.peek() == "/" {
+		op := p.tokens[p.pos]
+		p.pos++
+		right, err := p.parseAtom()
+		if err != nil {
+			return exprValue{}, err
+		}
+		left, err = combineMulDiv(left, right, op)
+		if err != nil {
+			return exprValue{}, err
+		}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAtom() (exprValue, error) {
+	if p.peek() == "(" {
+		p.pos++
+		v, err := p.parseSum()
+		if err != nil {
+			return exprValue{}, err
+		}
+		if p.peek() != ")" {
+			return exprValue{}, fmt.Errorf("%w: missing closing parenthesis", ErrExprSyntax)
+		}
+		p.pos++
+		return v, nil
+	}
+
+	// Unary minus: negate whatever atom follows ("-USD 5", "-(USD 1 + USD 2)", "-2").
+	if p.peek() == "-" {
+		p.pos++
+		v, err := p.parseAtom()
+		if err != nil {
+			return exprValue{}, err
+		}
+		return v.negated()
+	}
+
+	if p.pos >= len(p.tokens) {
+		return exprValue{}, fmt.Errorf("%w: unexpected end of expression", ErrExprSyntax)
+	}
+
+	first := p.tokens[p.pos]
+	if r, err := zerorat.ParseRat(first); err == nil {
+		p.pos++
+		if p.peek() == "%" {
+			return p.parsePercentOf(r)
+		}
+		return exprValue{scalar: r, isScalar: true}, nil
+	}
+
+	// Otherwise first must be a currency code, with the amount in the following token. The
+	// amount may itself be negative ("EUR -3"); tokenizeExpr always splits the sign off into
+	// its own "-" token, so it has to be re-merged with the number that follows it here.
+	if p.pos+1 >= len(p.tokens) {
+		return exprValue{}, fmt.Errorf("%w: expected amount after currency %q", ErrExprSyntax, first)
+	}
+	amountTok := p.tokens[p.pos+1]
+	consumed := 2
+	if amountTok == "-" {
+		if p.pos+2 >= len(p.tokens) {
+			return exprValue{}, fmt.Errorf("%w: expected amount after currency %q", ErrExprSyntax, first)
+		}
+		amountTok = "-" + p.tokens[p.pos+2]
+		consumed = 3
+	}
+	m, err := ParseMoneyDecimal(first + " " + amountTok)
+	if err != nil {
+		return exprValue{}, fmt.Errorf("%w: %v", ErrExprSyntax, err)
+	}
+	p.pos += consumed
+	return exprValue{m: m, isMoney: true}, nil
+}
+
+// parsePercentOf parses the "of X" tail of a "N% of X" construct with "%" already peeked but
+// not consumed, and applies percent as a multiplier to the Money that X evaluates to.
+func (p *exprParser) parsePercentOf(percent zerorat.Rat) (exprValue, error) {
+	p.pos++ // consume "%"
+	if p.peek() != "of" {
+		return exprValue{}, fmt.Errorf("%w: expected %q after %%", ErrExprSyntax, "of")
+	}
+	p.pos++ // consume "of"
+	operand, err := p.parseAtom()
+	if err != nil {
+		return exprValue{}, err
+	}
+	if !operand.isMoney {
+		return exprValue{}, fmt.Errorf("%w: %q requires a Money operand", ErrExprSyntax, "% of")
+	}
+	ratio := percent
+	ratio.DivInt(percentDivisor)
+	result, err := operand.m.MultipliedRatErr(ratio)
+	if err != nil {
+		return exprValue{}, err
+	}
+	return exprValue{m: result, isMoney: true}, nil
+}
+
+func combineAddSub(left, right exprValue, op string, allowBag bool) (exprValue, error) {
+	leftHasAmount := left.isMoney || left.isBag
+	rightHasAmount := right.isMoney || right.isBag
+	if !leftHasAmount || !rightHasAmount {
+		return exprValue{}, fmt.Errorf("%w: %q requires two Money operands", ErrExprSyntax, op)
+	}
+
+	if !left.isBag && !right.isBag && (!allowBag || left.m.SameCurrency(right.m)) {
+		// Not mixing currencies (or bag support isn't enabled): let Added/SubtractedErr handle
+		// both the matching-currency success path and invalid/mismatch error reporting, exactly
+		// as EvaluateExpr always has.
+		var result Money
+		var err error
+		if op == "+" {
+			result, err = left.m.AddedErr(right.m)
+		} else {
+			result, err = left.m.SubtractedErr(right.m)
+		}
+		if err != nil {
+			return exprValue{}, err
+		}
+		return exprValue{m: result, isMoney: true}, nil
+	}
+
+	bag := NewBag()
+	if err := mergeIntoBag(&bag, left, 1); err != nil {
+		return exprValue{}, err
+	}
+	sign := 1
+	if op == "-" {
+		sign = -1
+	}
+	if err := mergeIntoBag(&bag, right, sign); err != nil {
+		return exprValue{}, err
+	}
+	return exprValue{b: bag, isBag: true}, nil
+}
+
+// mergeIntoBag adds v (a Money or Bag) into bag, negating every entry first when sign < 0.
+func mergeIntoBag(bag *Bag, v exprValue, sign int) error {
+	entries := v.b.Entries()
+	if v.isMoney {
+		entries = []Money{v.m}
+	}
+	for _, m := range entries {
+		if sign < 0 {
+			var err error
+			if m, err = m.MultipliedIntErr(-1); err != nil {
+				return err
+			}
+		}
+		if err := bag.Add(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func combineMulDiv(left, right exprValue, op string) (exprValue, error) {
+	switch {
+	case left.isMoney && right.isScalar:
+		return applyScalar(left.m, right.scalar, op)
+	case left.isScalar && right.isMoney && op == "*":
+		return applyScalar(right.m, left.scalar, op)
+	case left.isBag && right.isScalar:
+		return applyScalarToBag(left.b, right.scalar, op)
+	case left.isScalar && right.isBag && op == "*":
+		return applyScalarToBag(right.b, left.scalar, op)
+	default:
+		return exprValue{}, fmt.Errorf("%w: %q requires exactly one Money and one scalar operand", ErrExprSyntax, op)
+	}
+}
+
+func applyScalar(m Money, scalar zerorat.Rat, op string) (exprValue, error) {
+	var result Money
+	var err error
+	if op == "*" {
+		result, err = m.MultipliedRatErr(scalar)
+	} else {
+		result, err = m.DividedRatErr(scalar)
+	}
+	if err != nil {
+		return exprValue{}, err
+	}
+	return exprValue{m: result, isMoney: true}, nil
+}
+
+func applyScalarToBag(b Bag, scalar zerorat.Rat, op string) (exprValue, error) {
+	result := NewBag()
+	for _, m := range b.Entries() {
+		scaled, err := applyScalar(m, scalar, op)
+		if err != nil {
+			return exprValue{}, err
+		}
+		if err := result.Add(scaled.m); err != nil {
+			return exprValue{}, err
+		}
+	}
+	return exprValue{b: result, isBag: true}, nil
+}
+
+// tokenizeExpr splits expr into currency codes, amounts/scalars, operators, percent signs, and
+// parentheses.
+func tokenizeExpr(expr string) ([]string, error) {
+	var tokens []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	for _, r := range expr {
+		switch {
+		case r == '+' || r == '-' || r == '*' || r == '/' || r == '(' || r == ')' || r == '%':
+			flush()
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n':
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	flush()
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", ErrExprSyntax)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,131 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluateExprAddSub(t *testing.T) {
+	got, err := EvaluateExpr("USD 10.50 + USD 2.25 - USD 0.75")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want := NewMoneyFromFraction(1200, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got, want)
+	}
+}
+
+func TestEvaluateExprPrecedenceAndParens(t *testing.T) {
+	got, err := EvaluateExpr("USD 10 + USD 4 * 2")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want := NewMoneyFromFraction(1800, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got, want)
+	}
+
+	got2, err := EvaluateExpr("(USD 10 + USD 4) * 2")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want2 := NewMoneyFromFraction(2800, 100, "USD")
+	if !got2.Equal(want2) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got2, want2)
+	}
+}
+
+func TestEvaluateExprCurrencyMismatch(t *testing.T) {
+	if _, err := EvaluateExpr("USD 10 + EUR 5"); err == nil {
+		t.Fatal("expected error for mismatched currencies")
+	}
+}
+
+func TestEvaluateExprSyntaxError(t *testing.T) {
+	if _, err := EvaluateExpr("USD 10 +"); err == nil {
+		t.Fatal("expected syntax error for trailing operator")
+	}
+	if _, err := EvaluateExpr(""); err == nil {
+		t.Fatal("expected syntax error for empty expression")
+	}
+}
+
+func TestEvaluateExprNegativeLiterals(t *testing.T) {
+	got, err := EvaluateExpr("EUR -3")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want := NewMoneyFromFraction(-3, 1, "EUR")
+	if !got.Equal(want) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got, want)
+	}
+
+	got2, err := EvaluateExpr("USD 10 * -2")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want2 := NewMoneyFromFraction(-20, 1, "USD")
+	if !got2.Equal(want2) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got2, want2)
+	}
+
+	got3, err := EvaluateExpr("-(USD 5 + USD 1)")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want3 := NewMoneyFromFraction(-6, 1, "USD")
+	if !got3.Equal(want3) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got3, want3)
+	}
+}
+
+func TestEvaluateExprPercentOf(t *testing.T) {
+	got, err := EvaluateExpr("(USD 12.50 + USD 0.99) * 3 - 15% of USD 50")
+	if err != nil {
+		t.Fatalf("EvaluateExpr: %v", err)
+	}
+	want := NewMoneyFromFraction(3297, 100, "USD") // (13.49 * 3) - 7.50 = 40.47 - 7.50
+	if !got.Equal(want) {
+		t.Errorf("EvaluateExpr() = %s, want %s", got, want)
+	}
+}
+
+func TestEvaluateExprBagMixedCurrency(t *testing.T) {
+	got, err := EvaluateExprBag("USD 10 + EUR 5 - USD 2")
+	if err != nil {
+		t.Fatalf("EvaluateExprBag: %v", err)
+	}
+	usd := got.Get("USD")
+	if !usd.Equal(NewMoneyFromFraction(8, 1, "USD")) {
+		t.Errorf("USD entry = %s, want 8 USD", usd)
+	}
+	eur := got.Get("EUR")
+	if !eur.Equal(NewMoneyFromFraction(5, 1, "EUR")) {
+		t.Errorf("EUR entry = %s, want 5 EUR", eur)
+	}
+}
+
+func TestEvaluateExprBagSingleCurrency(t *testing.T) {
+	got, err := EvaluateExprBag("USD 10 + USD 5")
+	if err != nil {
+		t.Fatalf("EvaluateExprBag: %v", err)
+	}
+	if len(got.Currencies()) != 1 {
+		t.Fatalf("expected a single currency entry, got %v", got.Currencies())
+	}
+	usd := got.Get("USD")
+	if !usd.Equal(NewMoneyFromFraction(15, 1, "USD")) {
+		t.Errorf("USD entry = %s, want 15 USD", usd)
+	}
+}
+
+func TestEvaluateExprSendNotSupported(t *testing.T) {
+	_, err := EvaluateExpr("send USD 50 from Alice to Bob")
+	if err == nil {
+		t.Fatal("expected an error for unsupported send construct")
+	}
+	if !errors.Is(err, ErrExprSendNotSupported) {
+		t.Errorf("EvaluateExpr() error = %v, want ErrExprSendNotSupported", err)
+	}
+}
@@ -0,0 +1,8 @@
+package money
+
+// NewMoneyFloatApprox creates a Money from a float64 value using the best rational approximation
+// with a denominator no larger than maxDenom. Equivalent to NewMoneyApproximated; provided under
+// this name for call-site symmetry with NewMoneyFloat/NewMoneyFromDecimal.
+func NewMoneyFloatApprox(currency Currency, value float64, maxDenom uint64) (Money, error) {
+	return NewMoneyApproximated(currency, value, maxDenom)
+}
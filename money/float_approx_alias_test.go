@@ -0,0 +1,14 @@
+package money
+
+import "testing"
+
+func TestNewMoneyFloatApproxMatchesNewMoneyApproximated(t *testing.T) {
+	got, err := NewMoneyFloatApprox("USD", 0.1, 1000)
+	if err != nil {
+		t.Fatalf("NewMoneyFloatApprox: %v", err)
+	}
+	want := NewMoneyFromFraction(1, 10, "USD")
+	if !got.Equal(want) {
+		t.Errorf("NewMoneyFloatApprox(0.1) = %v, want %v", got, want)
+	}
+}
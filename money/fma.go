@@ -0,0 +1,26 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// FMA computes base*rate + addend (fused multiply-add) in one step, for the common "apply a rate
+// then add a fee" calculation. addend must share base's currency. Returns an error if either
+// operand is invalid, the currencies mismatch, or the computation overflows.
+func FMA(base Money, rate zerorat.Rat, addend Money) (Money, error) {
+	if base.IsInvalid() || addend.IsInvalid() {
+		return Money{}, ErrMoneyInvalid
+	}
+	scaled, err := base.MultipliedRatErr(rate)
+	if err != nil {
+		return Money{}, err
+	}
+	return scaled.AddedErr(addend)
+}
+
+// Pow raises m's amount to the integer power n, keeping m's currency. This is mostly useful for
+// compounding-style calculations (e.g. (1+rate)^periods applied via MultipliedRat) rather than a
+// literal "money squared," which has no natural unit.
+func (m Money) Pow(n int) Money {
+	result := m
+	result.amount = result.amount.Powed(n)
+	return result
+}
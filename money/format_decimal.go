@@ -0,0 +1,12 @@
+package money
+
+// FormatDecimal renders m's amount as a fixed-point decimal string with exactly prec fractional
+// digits, rounded half-to-even. Unlike Decimal(), which always uses the currency's registered
+// minor-unit precision, FormatDecimal lets the caller pick the precision explicitly (e.g. for
+// display contexts that want more or fewer digits than the currency's minor unit).
+func (m Money) FormatDecimal(prec int) (string, error) {
+	if m.IsInvalid() {
+		return "", ErrMoneyInvalid
+	}
+	return m.amount.FormatDecimal(prec), nil
+}
@@ -0,0 +1,22 @@
+package money
+
+import "testing"
+
+func TestMoneyFormatDecimal(t *testing.T) {
+	m := NewMoneyFromFraction(1, 8, "USD") // 0.125
+	got, err := m.FormatDecimal(2)
+	if err != nil {
+		t.Fatalf("FormatDecimal: %v", err)
+	}
+	if got != "0.12" { // half-to-even: 0.125 rounds to 0.12
+		t.Errorf("FormatDecimal(2) = %q, want %q", got, "0.12")
+	}
+}
+
+func TestMoneyFormatDecimalInvalid(t *testing.T) {
+	var m Money
+	m.Invalidate()
+	if _, err := m.FormatDecimal(2); err == nil {
+		t.Fatal("expected error for invalid Money")
+	}
+}
@@ -0,0 +1,15 @@
+package money
+
+import "fmt"
+
+// Format implements fmt.Formatter. %v and %s print String() (e.g. "USD/5/4"), while %f prints
+// the human-readable decimal amount at the currency's registered minor-unit precision (e.g.
+// "12.34"), mirroring zerorat.Rat.Format's verb handling.
+func (m Money) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'f':
+		_, _ = fmt.Fprint(f, m.amount.FloatString(MinorUnits(m.currency)))
+	default:
+		_, _ = fmt.Fprint(f, m.String())
+	}
+}
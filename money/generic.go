@@ -0,0 +1,109 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// Numeric constrains the underlying integer width a GenericMoney can be stored in, letting
+// callers pick int32 for compact storage or int64 for headroom, independent of zerorat.Rat's
+// fixed int64/uint64 layout.
+type Numeric interface {
+	~int32 | ~int64
+}
+
+// GenericMoney is a Money-like value parameterized over its underlying integer type T, storing
+// the amount as a plain minor-unit integer (e.g. cents) rather than a zerorat.Rat. Use this when
+// you need compile-time control over the storage width and don't need exact rational arithmetic.
+type GenericMoney[T Numeric] struct {
+	currency Currency
+	minor    T // amount expressed in minor units (e.g. cents)
+}
+
+// NewGenericMoney creates a GenericMoney from a minor-unit integer amount.
+func NewGenericMoney[T Numeric](currency Currency, minor T) GenericMoney[T] {
+	return GenericMoney[T]{currency: currency, minor: minor}
+}
+
+// Currency returns the currency code.
+func (g GenericMoney[T]) Currency() Currency {
+	return g.currency
+}
+
+// Minor returns the amount expressed in minor units.
+func (g GenericMoney[T]) Minor() T {
+	return g.minor
+}
+
+// Add returns the sum of g and other (immutable operation). Returns an error on currency mismatch.
+func (g GenericMoney[T]) Add(other GenericMoney[T]) (GenericMoney[T], error) {
+	if g.currency != other.currency {
+		return GenericMoney[T]{}, ErrMoneyCurrencyMismatch
+	}
+	return GenericMoney[T]{currency: g.currency, minor: g.minor + other.minor}, nil
+}
+
+// Sub returns the difference of g and other (immutable operation). Returns an error on currency mismatch.
+func (g GenericMoney[T]) Sub(other GenericMoney[T]) (GenericMoney[T], error) {
+	if g.currency != other.currency {
+		return GenericMoney[T]{}, ErrMoneyCurrencyMismatch
+	}
+	return GenericMoney[T]{currency: g.currency, minor: g.minor - other.minor}, nil
+}
+
+// Mul scales g by factor, truncating any fractional minor units.
+func (g GenericMoney[T]) Mul(factor T) GenericMoney[T] {
+	return GenericMoney[T]{currency: g.currency, minor: g.minor * factor}
+}
+
+// Cmp compares g and other, returning -1, 0, or +1. Mismatched currencies compare by currency
+// code so GenericMoney remains totally ordered for sorting.
+func (g GenericMoney[T]) Cmp(other GenericMoney[T]) int {
+	if g.currency != other.currency {
+		if g.currency < other.currency {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case g.minor < other.minor:
+		return -1
+	case g.minor > other.minor:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// String renders the amount as "currency minor" for debugging.
+func (g GenericMoney[T]) String() string {
+	return fmt.Sprintf("%s %v", g.currency, g.minor)
+}
+
+// ToMoney converts g to the exact-rational Money representation, using the currency's registered
+// MinorUnits to place the decimal point.
+func (g GenericMoney[T]) ToMoney() Money {
+	scale := pow10(MinorUnits(g.currency))
+	return NewMoneyFromFraction(int64(g.minor), scale, g.currency)
+}
+
+// FromMoney converts m to a GenericMoney[T], expressing the amount in minor units at the
+// currency's registered MinorUnits scale. Returns an error if m is invalid or the amount does not
+// fall on an exact minor-unit boundary.
+func FromMoney[T Numeric](m Money) (GenericMoney[T], error) {
+	if m.IsInvalid() {
+		return GenericMoney[T]{}, ErrMoneyInvalid
+	}
+	scale := pow10(MinorUnits(m.Currency()))
+	minorRat := m.Amount()
+	minorRat.Mul(zerorat.NewFromInt(int64(scale)))
+	if !minorRat.IsInteger() {
+		return GenericMoney[T]{}, ErrMoneyInvalid
+	}
+	minor, ok := minorRat.TruncInt64()
+	if !ok {
+		return GenericMoney[T]{}, ErrMoneyInvalid
+	}
+	return GenericMoney[T]{currency: m.Currency(), minor: T(minor)}, nil
+}
@@ -0,0 +1,37 @@
+package money
+
+import "testing"
+
+func TestGenericMoneyToMoneyRoundTrip(t *testing.T) {
+	g := NewGenericMoney[int64]("USD", 1234) // $12.34
+
+	m := g.ToMoney()
+	if m.Currency() != "USD" {
+		t.Fatalf("ToMoney() currency = %q, want USD", m.Currency())
+	}
+
+	back, err := FromMoney[int64](m)
+	if err != nil {
+		t.Fatalf("FromMoney: %v", err)
+	}
+	if back.Minor() != g.Minor() {
+		t.Errorf("round trip minor = %d, want %d", back.Minor(), g.Minor())
+	}
+}
+
+func TestGenericMoneyMulAndCmp(t *testing.T) {
+	a := NewGenericMoney[int32]("EUR", 100)
+	b := a.Mul(3)
+	if b.Minor() != 300 {
+		t.Errorf("Mul(3).Minor() = %d, want 300", b.Minor())
+	}
+	if a.Cmp(b) >= 0 {
+		t.Errorf("Cmp(a, b) = %d, want negative (a < b)", a.Cmp(b))
+	}
+	if b.Cmp(a) <= 0 {
+		t.Errorf("Cmp(b, a) = %d, want positive (b > a)", b.Cmp(a))
+	}
+	if a.Cmp(a) != 0 {
+		t.Errorf("Cmp(a, a) = %d, want 0", a.Cmp(a))
+	}
+}
@@ -0,0 +1,99 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// LocaleFormat describes the separators used to render a Money amount for a given locale,
+// e.g. {ThousandsSep: ",", DecimalSep: "."} for en-US or {ThousandsSep: ".", DecimalSep: ","}
+// for de-DE.
+type LocaleFormat struct {
+	ThousandsSep string
+	DecimalSep   string
+	// NegativeTemplate controls how negative amounts render; "%s" is replaced with the unsigned
+	// formatted amount. Defaults to "-%s" (e.g. "-1,234.56") when empty; accounting styles can
+	// pass "(%s)" to get "(1,234.56)" instead.
+	NegativeTemplate string
+}
+
+// defaultLocale mirrors en-US grouping, used when callers don't supply a LocaleFormat.
+var defaultLocale = LocaleFormat{ThousandsSep: ",", DecimalSep: "."}
+
+// FormatLocale renders m using the given locale's separators and the currency's registered
+// minor-unit precision, e.g. "1,234.56".
+func (m Money) FormatLocale(locale LocaleFormat) (string, error) {
+	if m.IsInvalid() {
+		return "", ErrMoneyInvalid
+	}
+
+	prec := MinorUnits(m.currency)
+	plain := m.amount.FloatString(prec)
+	if plain == "invalid" {
+		return "", ErrMoneyInvalid
+	}
+
+	neg := strings.HasPrefix(plain, "-")
+	if neg {
+		plain = plain[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(plain, ".")
+	grouped := groupThousands(intPart, locale.ThousandsSep)
+
+	var sb strings.Builder
+	sb.WriteString(grouped)
+	if prec > 0 {
+		sb.WriteString(locale.DecimalSep)
+		sb.WriteString(fracPart)
+	}
+	unsigned := sb.String()
+
+	if !neg {
+		return unsigned, nil
+	}
+
+	template := locale.NegativeTemplate
+	if template == "" {
+		template = "-%s"
+	}
+	return strings.Replace(template, "%s", unsigned, 1), nil
+}
+
+// groupThousands inserts sep every three digits from the right of digits.
+func groupThousands(digits, sep string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	var parts []string
+	for len(digits) > 3 {
+		parts = append([]string{digits[len(digits)-3:]}, parts...)
+		digits = digits[:len(digits)-3]
+	}
+	parts = append([]string{digits}, parts...)
+	return strings.Join(parts, sep)
+}
+
+// ParseMoneyLocale parses an amount string formatted with locale's separators, paired with
+// currency, into a Money value.
+func ParseMoneyLocale(currency Currency, amount string, locale LocaleFormat) (Money, error) {
+	amount = strings.TrimSpace(amount)
+	neg := strings.HasPrefix(amount, "-")
+	if neg {
+		amount = amount[1:]
+	}
+
+	amount = strings.ReplaceAll(amount, locale.ThousandsSep, "")
+	amount = strings.Replace(amount, locale.DecimalSep, ".", 1)
+
+	value, err := zerorat.ParseRat(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	if neg {
+		value = value.Negated()
+	}
+
+	return NewMoneyErr(currency, value)
+}
@@ -0,0 +1,13 @@
+package money
+
+// FormatGrouped renders m using en-US-style grouping ("1,234.56"), equivalent to
+// FormatLocale(defaultLocale) but without requiring the caller to build a LocaleFormat for the
+// common case.
+func (m Money) FormatGrouped() (string, error) {
+	return m.FormatLocale(defaultLocale)
+}
+
+// ParseMoneyGrouped parses amount using en-US-style grouping, the counterpart to FormatGrouped.
+func ParseMoneyGrouped(currency Currency, amount string) (Money, error) {
+	return ParseMoneyLocale(currency, amount, defaultLocale)
+}
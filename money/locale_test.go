@@ -0,0 +1,45 @@
+package money
+
+import "testing"
+
+func TestFormatLocaleUSAndDE(t *testing.T) {
+	m := NewMoneyFromFraction(123456, 100, "USD") // $1234.56
+
+	us, err := m.FormatLocale(defaultLocale)
+	if err != nil {
+		t.Fatalf("FormatLocale(en-US): %v", err)
+	}
+	if us != "1,234.56" {
+		t.Errorf("FormatLocale(en-US) = %q, want \"1,234.56\"", us)
+	}
+
+	de, err := m.FormatLocale(LocaleFormat{ThousandsSep: ".", DecimalSep: ","})
+	if err != nil {
+		t.Fatalf("FormatLocale(de-DE): %v", err)
+	}
+	if de != "1.234,56" {
+		t.Errorf("FormatLocale(de-DE) = %q, want \"1.234,56\"", de)
+	}
+}
+
+func TestFormatLocaleNegative(t *testing.T) {
+	m := NewMoneyFromFraction(-500, 100, "USD")
+	got, err := m.FormatLocale(LocaleFormat{ThousandsSep: ",", DecimalSep: ".", NegativeTemplate: "(%s)"})
+	if err != nil {
+		t.Fatalf("FormatLocale: %v", err)
+	}
+	if got != "(5.00)" {
+		t.Errorf("FormatLocale() = %q, want \"(5.00)\"", got)
+	}
+}
+
+func TestParseMoneyGroupedRoundTrip(t *testing.T) {
+	got, err := ParseMoneyGrouped("USD", "1,234.56")
+	if err != nil {
+		t.Fatalf("ParseMoneyGrouped: %v", err)
+	}
+	want := NewMoneyFromFraction(123456, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyGrouped() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,33 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// NewMoneyMinor creates a Money from an amount expressed in minor units (e.g. cents), using
+// currency's registered MinorUnits to place the decimal point, so NewMoneyMinor("USD", 199)
+// yields $1.99.
+func NewMoneyMinor(currency Currency, minorUnits int64) Money {
+	scale := pow10(MinorUnits(currency))
+	return NewMoneyFromFraction(minorUnits, scale, currency)
+}
+
+// Minor returns m's amount expressed as an integer count of minor units at currency's registered
+// MinorUnits scale (e.g. 199 for $1.99). The second return value is false if m is invalid or the
+// amount doesn't fall on an exact minor-unit boundary.
+func (m Money) Minor() (int64, bool) {
+	if m.IsInvalid() {
+		return 0, false
+	}
+	scale := pow10(MinorUnits(m.currency))
+	scaled := m.amount
+	scaled.Mul(zerorat.NewFromInt(int64(scale)))
+	if !scaled.IsInteger() {
+		return 0, false
+	}
+	return scaled.TruncInt64()
+}
+
+// Major returns m's amount as a whole-currency-unit Rat (e.g. 1.99 for $1.99), i.e. the exact
+// amount with no minor-unit scaling applied.
+func (m Money) Major() zerorat.Rat {
+	return m.amount
+}
@@ -0,0 +1,37 @@
+package money
+
+import "testing"
+
+func TestNewMoneyMinor(t *testing.T) {
+	got := NewMoneyMinor("USD", 199)
+	want := NewMoneyFromFraction(199, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("NewMoneyMinor(USD, 199) = %v, want %v", got, want)
+	}
+}
+
+func TestMoneyMinorRoundTrip(t *testing.T) {
+	m := NewMoneyMinor("USD", 199)
+	minor, ok := m.Minor()
+	if !ok {
+		t.Fatal("Minor() reported failure")
+	}
+	if minor != 199 {
+		t.Errorf("Minor() = %d, want 199", minor)
+	}
+}
+
+func TestMoneyMajor(t *testing.T) {
+	m := NewMoneyMinor("USD", 199)
+	if !m.Major().Equal(m.Amount()) {
+		t.Errorf("Major() = %v, want %v", m.Major(), m.Amount())
+	}
+}
+
+func TestMoneyMinorInvalid(t *testing.T) {
+	var m Money
+	m.Invalidate()
+	if _, ok := m.Minor(); ok {
+		t.Error("Minor() on invalid Money reported success, want failure")
+	}
+}
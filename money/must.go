@@ -0,0 +1,100 @@
+package money
+
+import (
+	"fmt"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// MustAdd returns the sum of m and other, panicking if the operation fails (currency mismatch,
+// invalid operand, or overflow). For pipeline code that has already validated its inputs and
+// would rather fail loudly than propagate a silently-invalid Money.
+func (m Money) MustAdd(other Money) Money {
+	result, err := m.AddedErr(other)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustAdd: %v", err))
+	}
+	return result
+}
+
+// MustSub returns the difference of m and other, panicking on error. See MustAdd.
+func (m Money) MustSub(other Money) Money {
+	result, err := m.SubtractedErr(other)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustSub: %v", err))
+	}
+	return result
+}
+
+// MustMulRat returns m multiplied by value, panicking on error. See MustAdd.
+func (m Money) MustMulRat(value zerorat.Rat) Money {
+	result, err := m.MultipliedRatErr(value)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustMulRat: %v", err))
+	}
+	return result
+}
+
+// MustDivRat returns m divided by value, panicking on error. See MustAdd.
+func (m Money) MustDivRat(value zerorat.Rat) Money {
+	result, err := m.DividedRatErr(value)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustDivRat: %v", err))
+	}
+	return result
+}
+
+// MustNewMoney constructs a Money, panicking if currency is empty or amount is invalid.
+// For pipeline/setup code (e.g. package-level prices) where an invalid literal is a programmer error.
+func MustNewMoney(currency Currency, amount zerorat.Rat) Money {
+	result, err := NewMoneyErr(currency, amount)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustNewMoney: %v", err))
+	}
+	return result
+}
+
+// MustPercent returns m as a percentage of other, panicking on error. See MustAdd.
+func (m Money) MustPercent(other Money) Money {
+	result, err := m.PercentOfErr(other)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustPercent: %v", err))
+	}
+	return result
+}
+
+// MustConvert converts m into targetCurrency using provider, panicking on error. See MustAdd.
+func (m Money) MustConvert(targetCurrency Currency, provider RateProvider) Money {
+	result, err := m.Convert(targetCurrency, provider)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustConvert: %v", err))
+	}
+	return result
+}
+
+// MustAllocate splits m via Allocate, panicking on error instead of returning one.
+func (m Money) MustAllocate(ratios ...int64) []Money {
+	result, err := m.Allocate(ratios...)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustAllocate: %v", err))
+	}
+	return result
+}
+
+// MustToProto converts m into the google.type.Money wire shape, panicking on error. See MustAdd.
+func (m Money) MustToProto() ProtoMoney {
+	result, err := m.ToProto()
+	if err != nil {
+		panic(fmt.Sprintf("money: MustToProto: %v", err))
+	}
+	return result
+}
+
+// MustFromProto builds a Money from a google.type.Money wire value, panicking on error.
+func MustFromProto(p ProtoMoney) Money {
+	result, err := FromProto(p)
+	if err != nil {
+		panic(fmt.Sprintf("money: MustFromProto: %v", err))
+	}
+	return result
+}
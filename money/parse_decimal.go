@@ -0,0 +1,27 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// ParseMoneyDecimal parses strings of the form "CUR 1.25" or "CUR 1.25e-3" (currency followed by
+// a decimal or scientific-notation amount, separated by whitespace), complementing ParseMoney's
+// "currency/numerator/denominator" format for human-entered input.
+func ParseMoneyDecimal(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	parts := strings.Fields(s)
+	if len(parts) != 2 {
+		return Money{}, fmt.Errorf("money: expected \"CURRENCY amount\", got %q", s)
+	}
+
+	currency, amountStr := parts[0], parts[1]
+	amount, err := zerorat.ParseRat(amountStr)
+	if err != nil {
+		return Money{}, fmt.Errorf("money: parsing amount: %w", err)
+	}
+
+	return NewMoneyErr(currency, amount)
+}
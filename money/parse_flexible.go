@@ -0,0 +1,114 @@
+package money
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// Error definitions for ParseMoneyFlexible.
+var (
+	// ErrMoneyInvalidAmountString indicates the numeric portion of a ParseMoneyFlexible input
+	// couldn't be parsed (malformed separators, stray tokens, etc.).
+	ErrMoneyInvalidAmountString = errors.New("money: invalid amount string")
+
+	// ErrMoneyUnknownCurrency indicates ParseMoneyFlexible couldn't determine a currency for the
+	// input and no defaultCurrency was usable.
+	ErrMoneyUnknownCurrency = errors.New("money: unknown currency")
+)
+
+// currencySymbols maps common currency symbols to their ISO 4217 code, used by ParseMoneyFlexible
+// to recognize "$1,000.50"-style input that carries no explicit currency code.
+var currencySymbols = map[string]Currency{
+	"$": "USD",
+	"€": "EUR",
+	"£": "GBP",
+	"¥": "JPY",
+}
+
+// ParseMoneyFlexible parses a human-entered amount string that may carry its own currency marker
+// ("$1,000.50", "1.000,50 EUR", "USD 1000.50") or a bare number that falls back to
+// defaultCurrency. It also accepts "n/d [CCY]" fraction syntax, parsed via zerorat.ParseRat.
+// Locale separators are inferred from whichever of ',' and '.' appears last in the numeric
+// portion (the last one is taken as the decimal separator, the other as the thousands
+// separator). Returns ErrMoneyInvalidAmountString for malformed numeric input and
+// ErrMoneyUnknownCurrency when no currency marker is present and defaultCurrency is empty.
+func ParseMoneyFlexible(s string, defaultCurrency Currency) (Money, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Money{}, ErrMoneyInvalidAmountString
+	}
+
+	currency := defaultCurrency
+	for symbol, code := range currencySymbols {
+		if strings.HasPrefix(s, symbol) {
+			currency = code
+			s = strings.TrimSpace(s[len(symbol):])
+			break
+		}
+	}
+
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 1:
+		// Bare amount; currency comes from a symbol match above or defaultCurrency.
+	case 2:
+		switch {
+		case isKnownCurrency(fields[0]):
+			currency, s = fields[0], fields[1]
+		case isKnownCurrency(fields[1]):
+			currency, s = fields[1], fields[0]
+		default:
+			return Money{}, ErrMoneyUnknownCurrency
+		}
+	default:
+		return Money{}, ErrMoneyInvalidAmountString
+	}
+
+	if currency == "" {
+		return Money{}, ErrMoneyUnknownCurrency
+	}
+
+	if strings.Contains(s, "/") {
+		amount, err := zerorat.ParseRat(s)
+		if err != nil {
+			return Money{}, ErrMoneyInvalidAmountString
+		}
+		return NewMoneyErr(currency, amount)
+	}
+
+	m, err := ParseMoneyLocale(currency, s, inferLocale(s))
+	if err != nil {
+		return Money{}, ErrMoneyInvalidAmountString
+	}
+	return m, nil
+}
+
+// isKnownCurrency reports whether code matches a registered ISO 4217 currency.
+func isKnownCurrency(code string) bool {
+	_, ok := LookupCurrency(code)
+	return ok
+}
+
+// inferLocale guesses which of ',' and '.' is the decimal separator. When both appear, whichever
+// appears last in s is taken as the decimal separator and the other as the thousands separator.
+// When only one of them appears more than once, it can't be a decimal separator (there can only
+// be one of those) so it's treated as a thousands separator with no fractional part, e.g.
+// "1,000,000" or the European "1.000.000".
+func inferLocale(s string) LocaleFormat {
+	commaCount := strings.Count(s, ",")
+	periodCount := strings.Count(s, ".")
+
+	switch {
+	case commaCount > 1 && periodCount == 0:
+		return defaultLocale
+	case periodCount > 1 && commaCount == 0:
+		return LocaleFormat{ThousandsSep: ".", DecimalSep: ","}
+	}
+
+	if strings.LastIndexByte(s, ',') > strings.LastIndexByte(s, '.') {
+		return LocaleFormat{ThousandsSep: ".", DecimalSep: ","}
+	}
+	return defaultLocale
+}
@@ -0,0 +1,85 @@
+package money
+
+import "testing"
+
+func TestParseMoneyFlexibleDollarSign(t *testing.T) {
+	got, err := ParseMoneyFlexible("$1,000.50", "")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(100050, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"$1,000.50\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleEuropeanSeparators(t *testing.T) {
+	got, err := ParseMoneyFlexible("1.000,50 EUR", "")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(100050, 100, "EUR")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"1.000,50 EUR\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleCurrencyFirst(t *testing.T) {
+	got, err := ParseMoneyFlexible("USD 1000.50", "")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(100050, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"USD 1000.50\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleFraction(t *testing.T) {
+	got, err := ParseMoneyFlexible("1/3 USD", "")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(1, 3, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"1/3 USD\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleBareNumberUsesDefault(t *testing.T) {
+	got, err := ParseMoneyFlexible("-114000000000.99", "USD")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	if got.Currency() != "USD" {
+		t.Errorf("ParseMoneyFlexible currency = %q, want USD", got.Currency())
+	}
+}
+
+func TestParseMoneyFlexibleCommaGroupedInteger(t *testing.T) {
+	got, err := ParseMoneyFlexible("$1,000,000", "")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(1000000, 1, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"$1,000,000\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleCommaGroupedNegativeWithFraction(t *testing.T) {
+	got, err := ParseMoneyFlexible("-114,000,000,000.99", "USD")
+	if err != nil {
+		t.Fatalf("ParseMoneyFlexible: %v", err)
+	}
+	want := NewMoneyFromFraction(-11400000000099, 100, "USD")
+	if !got.Equal(want) {
+		t.Errorf("ParseMoneyFlexible(\"-114,000,000,000.99\") = %v, want %v", got, want)
+	}
+}
+
+func TestParseMoneyFlexibleUnknownCurrency(t *testing.T) {
+	if _, err := ParseMoneyFlexible("100.50", ""); err == nil {
+		t.Fatal("expected ErrMoneyUnknownCurrency")
+	}
+}
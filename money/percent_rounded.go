@@ -0,0 +1,14 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// PercentedOfRounded computes m as a percentage of other like PercentedOf, then rounds the result
+// to the currency's registered minor-unit scale using roundType, for callers that need a
+// configurable rounding mode (e.g. banker's rounding) instead of carrying the exact fraction.
+func (m Money) PercentedOfRounded(other Money, roundType zerorat.RoundType) Money {
+	result := m.PercentedOf(other)
+	if result.IsInvalid() {
+		return result
+	}
+	return result.Rounded(roundType, MinorUnits(result.currency))
+}
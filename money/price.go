@@ -0,0 +1,24 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// Price represents a per-unit monetary rate, e.g. "$2.50 per kg". It's just Money under the
+// hood, but the distinct type keeps Price*Quantity call sites self-documenting and prevents
+// accidentally adding a Price to a Money total.
+type Price Money
+
+// NewPrice creates a Price from a currency and per-unit amount.
+func NewPrice(currency Currency, amount zerorat.Rat) Price {
+	return Price(NewMoney(currency, amount))
+}
+
+// Quantity is a dimensionless rational quantity (e.g. 2.5 kg, 3 units) multiplied against a Price.
+type Quantity = zerorat.Rat
+
+// Total multiplies a Price by a Quantity, returning the resulting Money (dimensional arithmetic:
+// price-per-unit * quantity-of-units = total money). Returns invalid Money on invalid operands
+// or overflow.
+func (p Price) Total(qty Quantity) Money {
+	m := Money(p)
+	return m.MultipliedRat(qty)
+}
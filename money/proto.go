@@ -0,0 +1,24 @@
+package money
+
+// ProtoMoney mirrors the shape of the generated Go struct for google.type.Money
+// (https://github.com/googleapis/go-genproto), letting callers interop without a direct
+// protobuf dependency in this package.
+type ProtoMoney struct {
+	CurrencyCode string
+	Units        int64
+	Nanos        int32
+}
+
+// ToProto converts m into the google.type.Money wire shape.
+func (m Money) ToProto() (ProtoMoney, error) {
+	v, err := m.ToUnitsNanos()
+	if err != nil {
+		return ProtoMoney{}, err
+	}
+	return ProtoMoney{CurrencyCode: v.Currency, Units: v.Units, Nanos: v.Nanos}, nil
+}
+
+// FromProto builds a Money from a google.type.Money wire value.
+func FromProto(p ProtoMoney) (Money, error) {
+	return FromUnitsNanos(UnitsNanos{Currency: p.CurrencyCode, Units: p.Units, Nanos: p.Nanos})
+}
@@ -0,0 +1,43 @@
+package money
+
+import "testing"
+
+func TestProtoRoundTrip(t *testing.T) {
+	want := NewMoneyFromFraction(1234, 100, "USD") // $12.34
+
+	p, err := want.ToProto()
+	if err != nil {
+		t.Fatalf("ToProto: %v", err)
+	}
+	if p.CurrencyCode != "USD" || p.Units != 12 || p.Nanos != 340000000 {
+		t.Errorf("ToProto() = %+v, want {USD 12 340000000}", p)
+	}
+
+	got, err := FromProto(p)
+	if err != nil {
+		t.Fatalf("FromProto: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+}
+
+func TestProtoNegativeAmount(t *testing.T) {
+	want := NewMoneyFromFraction(-550, 100, "EUR") // -$5.50
+
+	p, err := want.ToProto()
+	if err != nil {
+		t.Fatalf("ToProto: %v", err)
+	}
+	if p.Units != -5 || p.Nanos != -500000000 {
+		t.Errorf("ToProto() = %+v, want Units=-5 Nanos=-500000000", p)
+	}
+
+	got, err := FromProto(p)
+	if err != nil {
+		t.Fatalf("FromProto: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+}
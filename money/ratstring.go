@@ -0,0 +1,11 @@
+package money
+
+// RatString returns a compact "currency:numerator/denominator" rendering of m, always including
+// an explicit denominator (unlike String(), which defers entirely to zerorat.Rat.String()).
+// Returns "invalid" for invalid Money.
+func (m Money) RatString() string {
+	if m.IsInvalid() {
+		return invalidMoneyString
+	}
+	return m.currency + ":" + m.amount.RatString()
+}
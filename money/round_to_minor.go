@@ -0,0 +1,28 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// RoundToMinor rounds m to its currency's registered minor-unit scale (mutable operation),
+// e.g. 2 decimal places for USD or 0 for JPY, sparing callers from looking up MinorUnits
+// themselves before calling Round.
+func (m *Money) RoundToMinor(roundType zerorat.RoundType) error {
+	if m.IsInvalid() {
+		return ErrMoneyInvalid
+	}
+	return m.Round(roundType, MinorUnits(m.currency))
+}
+
+// RoundedToMinorErr returns a new Money rounded to its currency's registered minor-unit scale
+// (immutable operation with error).
+func (m Money) RoundedToMinorErr(roundType zerorat.RoundType) (Money, error) {
+	result := m
+	err := result.RoundToMinor(roundType)
+	return result, err
+}
+
+// RoundedToMinor returns a new Money rounded to its currency's registered minor-unit scale
+// (immutable operation without error). Returns invalid Money on error.
+func (m Money) RoundedToMinor(roundType zerorat.RoundType) Money {
+	result, _ := m.RoundedToMinorErr(roundType)
+	return result
+}
@@ -0,0 +1,27 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestRoundToMinorUSD(t *testing.T) {
+	m := NewMoneyFromFraction(123456, 1000, "USD") // $123.456
+
+	got := m.RoundedToMinor(zerorat.RoundHalfUp)
+	want := NewMoneyFromFraction(12346, 100, "USD") // $123.46
+	if !got.Equal(want) {
+		t.Errorf("RoundedToMinor() = %v, want %v", got, want)
+	}
+}
+
+func TestRoundToMinorJPYHasNoFraction(t *testing.T) {
+	m := NewMoneyFromFraction(1235, 10, "JPY") // 123.5
+
+	got := m.RoundedToMinor(zerorat.RoundHalfUp)
+	want := NewMoneyFromFraction(124, 1, "JPY")
+	if !got.Equal(want) {
+		t.Errorf("RoundedToMinor() = %v, want %v", got, want)
+	}
+}
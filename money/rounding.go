@@ -43,6 +43,27 @@ func (m Money) Rounded(roundType zerorat.RoundType, scale int) Money {
 	return result
 }
 
+// Truncate rounds the Money toward zero to the specified scale (mutable operation).
+// Equivalent to Round(zerorat.RoundDown, scale).
+func (m *Money) Truncate(scale int) error {
+	return m.Round(zerorat.RoundDown, scale)
+}
+
+// TruncatedErr returns a new Money truncated toward zero to the specified scale (immutable
+// operation with error).
+func (m Money) TruncatedErr(scale int) (Money, error) {
+	result := m // copy
+	err := result.Truncate(scale)
+	return result, err
+}
+
+// Truncated returns a new Money truncated toward zero to the specified scale (immutable
+// operation without error). Returns invalid Money on error.
+func (m Money) Truncated(scale int) Money {
+	result, _ := m.TruncatedErr(scale)
+	return result
+}
+
 // Ceil rounds the Money toward positive infinity to the specified scale (mutable operation).
 // Mathematical ceiling function: always rounds up for positive numbers, truncates for negative numbers.
 // Uses pointer receiver for mutable operation.
@@ -52,14 +73,8 @@ func (m *Money) Ceil(scale int) error {
 		return ErrMoneyInvalid
 	}
 
-	// For ceiling, we need to determine the correct rounding strategy based on sign
-	if m.amount.Sign() >= 0 {
-		// Positive or zero: use RoundUp (away from zero, which is toward positive infinity)
-		m.amount.Round(zerorat.RoundUp, scale)
-	} else {
-		// Negative: use RoundDown (toward zero, which is toward positive infinity for negatives)
-		m.amount.Round(zerorat.RoundDown, scale)
-	}
+	// RoundCeiling already implements "toward positive infinity" for both signs.
+	m.amount.Round(zerorat.RoundCeiling, scale)
 
 	// Check if Rat operation resulted in invalid state
 	if m.amount.IsInvalid() {
@@ -95,14 +110,8 @@ func (m *Money) Floor(scale int) error {
 		return ErrMoneyInvalid
 	}
 
-	// For floor, we need to determine the correct rounding strategy based on sign
-	if m.amount.Sign() >= 0 {
-		// Positive or zero: use RoundDown (toward zero, which is toward negative infinity)
-		m.amount.Round(zerorat.RoundDown, scale)
-	} else {
-		// Negative: use RoundUp (away from zero, which is toward negative infinity for negatives)
-		m.amount.Round(zerorat.RoundUp, scale)
-	}
+	// RoundFloor already implements "toward negative infinity" for both signs.
+	m.amount.Round(zerorat.RoundFloor, scale)
 
 	// Check if Rat operation resulted in invalid state
 	if m.amount.IsInvalid() {
@@ -0,0 +1,26 @@
+package money
+
+// SplitFrom divides m into n equal parts like Split, but starts distributing the remainder at
+// part index offset (wrapping around) instead of always favoring the first parts. Useful for
+// rotating which recipient absorbs the odd minor unit across repeated splits, e.g. a weekly
+// expense split where the same person shouldn't always get the extra cent.
+func (m Money) SplitFrom(n int, offset int) ([]Money, error) {
+	parts, err := m.Split(n)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return parts, nil
+	}
+
+	offset = ((offset % n) + n) % n
+	if offset == 0 {
+		return parts, nil
+	}
+
+	rotated := make([]Money, n)
+	for i, p := range parts {
+		rotated[(i+offset)%n] = p
+	}
+	return rotated, nil
+}
@@ -0,0 +1,36 @@
+package money
+
+import "testing"
+
+func TestSplitFromRotatesRemainder(t *testing.T) {
+	m := NewMoneyFromFraction(1001, 100, "USD") // $10.01 split 3 ways: 3.34, 3.34, 3.33 unrotated
+
+	base, err := m.Split(3)
+	if err != nil {
+		t.Fatalf("Split: %v", err)
+	}
+
+	rotated, err := m.SplitFrom(3, 1)
+	if err != nil {
+		t.Fatalf("SplitFrom: %v", err)
+	}
+
+	sum, err := SumErr(rotated...)
+	if err != nil {
+		t.Fatalf("SumErr: %v", err)
+	}
+	if !sum.Equal(m) {
+		t.Errorf("rotated parts do not sum back to original: got %s, want %s", sum, m)
+	}
+
+	if !rotated[1].Equal(base[0]) {
+		t.Errorf("SplitFrom(3, 1)[1] = %v, want %v (base[0])", rotated[1], base[0])
+	}
+}
+
+func TestSplitFromInvalidN(t *testing.T) {
+	m := NewMoneyFromFraction(100, 100, "USD")
+	if _, err := m.SplitFrom(0, 0); err == nil {
+		t.Fatal("expected error for n=0")
+	}
+}
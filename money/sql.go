@@ -0,0 +1,40 @@
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing Money using the same "currency/amount" text format
+// as String() so it round-trips through any driver that supports text columns.
+func (m Money) Value() (driver.Value, error) {
+	if m.IsInvalid() {
+		return nil, nil //nolint:nilnil // invalid Money maps to SQL NULL, not an error
+	}
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner, accepting NULL, string, or []byte column values produced by Value.
+func (m *Money) Scan(src any) error {
+	if src == nil {
+		m.Invalidate()
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("money: cannot scan %T into Money", src)
+	}
+
+	parsed, err := ParseMoney(s)
+	if err != nil {
+		return fmt.Errorf("money: scanning column: %w", err)
+	}
+	*m = parsed
+	return nil
+}
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/n-r-w/zerorat"
+)
+
+func TestMoneySQLRoundTrip(t *testing.T) {
+	want := NewMoney("USD", zerorat.New(5, 4))
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value(): %v", err)
+	}
+
+	var got Money
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan(%v): %v", value, err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("SQL round trip = %v, want %v", got, want)
+	}
+}
+
+func TestMoneySQLScanNull(t *testing.T) {
+	m := NewMoney("USD", zerorat.New(1, 1))
+	if err := m.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if m.IsValid() {
+		t.Error("Scan(nil) left Money valid, want invalid")
+	}
+}
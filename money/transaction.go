@@ -0,0 +1,16 @@
+package money
+
+// ApplyTransaction runs ops against b in order, as a single all-or-nothing unit: if any op
+// returns an error, b is restored to its state before the transaction started and that error is
+// returned, leaving none of the ops' effects applied. Useful for multi-step ledger updates (e.g.
+// debit one currency, credit another) that must not partially apply.
+func (b *Bag) ApplyTransaction(ops ...func(*Bag) error) error {
+	snapshot := b.Clone()
+	for _, op := range ops {
+		if err := op(b); err != nil {
+			*b = snapshot
+			return err
+		}
+	}
+	return nil
+}
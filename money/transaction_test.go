@@ -0,0 +1,44 @@
+package money
+
+import "testing"
+
+func TestApplyTransactionCommitsAllOnSuccess(t *testing.T) {
+	b := NewBag()
+	_ = b.Add(NewMoneyFromFraction(1000, 100, "USD"))
+
+	err := b.ApplyTransaction(
+		func(bag *Bag) error { return bag.Add(NewMoneyFromFraction(500, 100, "USD")) },
+		func(bag *Bag) error { return bag.Add(NewMoneyFromFraction(200, 100, "EUR")) },
+	)
+	if err != nil {
+		t.Fatalf("ApplyTransaction: %v", err)
+	}
+
+	if got := b.Get("USD"); !got.Equal(NewMoneyFromFraction(1500, 100, "USD")) {
+		t.Errorf("Get(USD) = %v, want 15.00", got)
+	}
+	if got := b.Get("EUR"); !got.Equal(NewMoneyFromFraction(200, 100, "EUR")) {
+		t.Errorf("Get(EUR) = %v, want 2.00", got)
+	}
+}
+
+func TestApplyTransactionRollsBackOnFailure(t *testing.T) {
+	b := NewBag()
+	_ = b.Add(NewMoneyFromFraction(1000, 100, "USD"))
+
+	err := b.ApplyTransaction(
+		func(bag *Bag) error { return bag.Add(NewMoneyFromFraction(500, 100, "USD")) },
+		func(bag *Bag) error { return bag.Add(Money{}) }, // invalid, forces failure
+		func(bag *Bag) error { return bag.Add(NewMoneyFromFraction(999, 100, "EUR")) },
+	)
+	if err == nil {
+		t.Fatal("expected ApplyTransaction to return an error")
+	}
+
+	if got := b.Get("USD"); !got.Equal(NewMoneyFromFraction(1000, 100, "USD")) {
+		t.Errorf("Get(USD) after rollback = %v, want original 10.00", got)
+	}
+	if got := b.Get("EUR"); got.IsValid() {
+		t.Errorf("Get(EUR) after rollback = %v, want absent", got)
+	}
+}
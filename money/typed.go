@@ -0,0 +1,50 @@
+package money
+
+import "github.com/n-r-w/zerorat"
+
+// CurrencyTag is implemented by phantom marker types used to parameterize TypedMoney, giving
+// compile-time currency safety: TypedMoney[USD] and TypedMoney[EUR] are distinct Go types, so
+// mixing them is a compile error rather than a runtime ErrMoneyCurrencyMismatch.
+type CurrencyTag interface {
+	// Code returns the ISO 4217 (or custom) currency code the tag represents.
+	Code() Currency
+}
+
+// TypedMoney is a Money value whose currency is encoded in the type parameter C rather than
+// checked at runtime. Convert to/from the runtime-checked Money via ToMoney/FromTypedMoney.
+type TypedMoney[C CurrencyTag] struct {
+	amount zerorat.Rat
+}
+
+// NewTypedMoney creates a TypedMoney from an amount.
+func NewTypedMoney[C CurrencyTag](amount zerorat.Rat) TypedMoney[C] {
+	return TypedMoney[C]{amount: amount}
+}
+
+// Amount returns the underlying zerorat.Rat amount.
+func (t TypedMoney[C]) Amount() zerorat.Rat {
+	return t.amount
+}
+
+// Add returns the sum of t and other; same currency is guaranteed by the shared type parameter.
+func (t TypedMoney[C]) Add(other TypedMoney[C]) TypedMoney[C] {
+	result := t.amount
+	result.Add(other.amount)
+	return TypedMoney[C]{amount: result}
+}
+
+// ToMoney converts t into a runtime-checked Money, reading the currency code from C's zero value.
+func (t TypedMoney[C]) ToMoney() Money {
+	var tag C
+	return NewMoney(tag.Code(), t.amount)
+}
+
+// FromTypedMoney converts a runtime-checked Money into a TypedMoney[C], returning an error if
+// m's currency doesn't match C's code.
+func FromTypedMoney[C CurrencyTag](m Money) (TypedMoney[C], error) {
+	var tag C
+	if m.Currency() != tag.Code() {
+		return TypedMoney[C]{}, ErrMoneyCurrencyMismatch
+	}
+	return TypedMoney[C]{amount: m.Amount()}, nil
+}
@@ -0,0 +1,71 @@
+package money
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/n-r-w/zerorat"
+)
+
+// nanosPerUnit is the scale used by the units+nanos wire format (1e9), matching google.type.Money.
+const nanosPerUnit = 1_000_000_000
+
+// UnitsNanos is the wire-friendly representation used by gRPC/JSON APIs that split an amount
+// into a whole-units part and a fractional nanos part (1 unit = 1_000_000_000 nanos), e.g.
+// google.type.Money. Units and Nanos always carry the same sign.
+type UnitsNanos struct {
+	Currency Currency
+	Units    int64
+	Nanos    int32
+}
+
+// ToUnitsNanos converts Money into its units+nanos wire representation.
+// Returns an error if m is invalid or the amount's total nanos (units*1e9 + nanos) does not fit
+// in an int64.
+func (m Money) ToUnitsNanos() (UnitsNanos, error) {
+	if m.IsInvalid() {
+		return UnitsNanos{}, ErrMoneyInvalid
+	}
+
+	scaled := m.amount
+	scaled.Round(zerorat.RoundDown, 9) // truncate to nanosecond-scale precision
+	if scaled.IsInvalid() {
+		return UnitsNanos{}, ErrMoneyInvalid
+	}
+
+	// After Round(.., 9) the amount is an exact multiple of 1/1e9; recover units and nanos by
+	// scaling the whole value by 1e9 and splitting off the fractional remainder.
+	totalNanos := scaled.Numerator()
+	denom := scaled.Denominator()
+	scale := int64(nanosPerUnit / denom) //nolint:gosec // denom divides nanosPerUnit by construction of Round(.., 9)
+	if nanosPerUnit%denom != 0 {
+		return UnitsNanos{}, ErrMoneyInvalid
+	}
+	if totalNanos > math.MaxInt64/scale || totalNanos < math.MinInt64/scale {
+		return UnitsNanos{}, ErrMoneyInvalid
+	}
+	totalNanos *= scale
+
+	units := totalNanos / nanosPerUnit
+	nanos := totalNanos % nanosPerUnit
+
+	return UnitsNanos{Currency: m.currency, Units: units, Nanos: int32(nanos)}, nil //nolint:gosec // bounded by nanosPerUnit
+}
+
+// FromUnitsNanos builds a Money from a units+nanos wire value.
+// Returns an error if the currency is empty or units/nanos have mismatched signs.
+func FromUnitsNanos(v UnitsNanos) (Money, error) {
+	if v.Currency == "" {
+		return Money{}, ErrMoneyInvalid
+	}
+	if (v.Units > 0 && v.Nanos < 0) || (v.Units < 0 && v.Nanos > 0) {
+		return Money{}, fmt.Errorf("money: units and nanos must have the same sign")
+	}
+	if v.Nanos <= -nanosPerUnit || v.Nanos >= nanosPerUnit {
+		return Money{}, fmt.Errorf("money: nanos must be in (-1e9, 1e9), got %d", v.Nanos)
+	}
+
+	total := v.Units*nanosPerUnit + int64(v.Nanos)
+	amount := zerorat.New(total, nanosPerUnit)
+	return NewMoneyErr(v.Currency, amount)
+}
@@ -0,0 +1,34 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// unitsNanosJSON mirrors the wire shape of google.type.Money: currencyCode, units, nanos.
+type unitsNanosJSON struct {
+	CurrencyCode string `json:"currencyCode"`
+	Units        int64  `json:"units"`
+	Nanos        int32  `json:"nanos"`
+}
+
+// MarshalJSON implements json.Marshaler for UnitsNanos using the google.type.Money field names.
+func (v UnitsNanos) MarshalJSON() ([]byte, error) {
+	return json.Marshal(unitsNanosJSON{
+		CurrencyCode: v.Currency,
+		Units:        v.Units,
+		Nanos:        v.Nanos,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for UnitsNanos.
+func (v *UnitsNanos) UnmarshalJSON(data []byte) error {
+	var wire unitsNanosJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return fmt.Errorf("money: decoding UnitsNanos: %w", err)
+	}
+	v.Currency = wire.CurrencyCode
+	v.Units = wire.Units
+	v.Nanos = wire.Nanos
+	return nil
+}
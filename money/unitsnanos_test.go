@@ -0,0 +1,44 @@
+package money
+
+import "testing"
+
+func TestUnitsNanosRoundTrip(t *testing.T) {
+	want := NewMoneyFromFraction(1234, 100, "USD") // $12.34
+
+	v, err := want.ToUnitsNanos()
+	if err != nil {
+		t.Fatalf("ToUnitsNanos: %v", err)
+	}
+	if v.Currency != "USD" || v.Units != 12 || v.Nanos != 340000000 {
+		t.Errorf("ToUnitsNanos() = %+v, want {USD 12 340000000}", v)
+	}
+
+	got, err := FromUnitsNanos(v)
+	if err != nil {
+		t.Fatalf("FromUnitsNanos: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("round trip = %v, want %v", got, want)
+	}
+}
+
+func TestFromUnitsNanosRejectsMismatchedSign(t *testing.T) {
+	_, err := FromUnitsNanos(UnitsNanos{Currency: "USD", Units: 1, Nanos: -1})
+	if err == nil {
+		t.Fatal("expected error for mismatched units/nanos sign")
+	}
+}
+
+func TestFromUnitsNanosRejectsOutOfRangeNanos(t *testing.T) {
+	_, err := FromUnitsNanos(UnitsNanos{Currency: "USD", Units: 1, Nanos: 1_000_000_000})
+	if err == nil {
+		t.Fatal("expected error for nanos >= 1e9")
+	}
+}
+
+func TestFromUnitsNanosRejectsEmptyCurrency(t *testing.T) {
+	_, err := FromUnitsNanos(UnitsNanos{Units: 1})
+	if err == nil {
+		t.Fatal("expected error for empty currency")
+	}
+}
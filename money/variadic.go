@@ -0,0 +1,43 @@
+package money
+
+// AddMoneyMany sums m with any number of other Money operands in one call, short-circuiting on
+// the first currency mismatch or invalid operand (returning that error).
+func (m Money) AddMoneyMany(others ...Money) (Money, error) {
+	result := m
+	for _, other := range others {
+		var err error
+		result, err = result.AddedErr(other)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
+// SubMoneyMany subtracts any number of Money operands from m in order, short-circuiting on the
+// first currency mismatch or invalid operand.
+func (m Money) SubMoneyMany(others ...Money) (Money, error) {
+	result := m
+	for _, other := range others {
+		var err error
+		result, err = result.SubtractedErr(other)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
+
+// MulMoneyMany multiplies m by any number of Money operands in order (each multiplication is a
+// PercentOf-style proportion), short-circuiting on the first currency mismatch or invalid operand.
+func (m Money) MulMoneyMany(others ...Money) (Money, error) {
+	result := m
+	for _, other := range others {
+		var err error
+		result, err = result.PercentOfErr(other)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,18 @@
+package zerorat
+
+import "strconv"
+
+// AppendText implements the encoding.TextAppender interface (mirroring math/big.Rat's
+// AppendText), appending the same format MarshalText produces to b without the intermediate
+// allocation a MarshalText call requires, consistent with this package's zero-allocation goals.
+func (r Rat) AppendText(b []byte) ([]byte, error) {
+	if r.IsInvalid() {
+		return b, ErrRatParseSyntax
+	}
+	b = strconv.AppendInt(b, r.numerator, 10)
+	if r.denominator != 1 {
+		b = append(b, '/')
+		b = strconv.AppendUint(b, r.denominator, 10)
+	}
+	return b, nil
+}
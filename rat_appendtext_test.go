@@ -0,0 +1,26 @@
+package zerorat
+
+import "testing"
+
+func TestAppendTextMatchesMarshalText(t *testing.T) {
+	r := New(3, 4)
+	want, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+
+	got, err := r.AppendText([]byte("prefix:"))
+	if err != nil {
+		t.Fatalf("AppendText: %v", err)
+	}
+	if string(got) != "prefix:"+string(want) {
+		t.Errorf("AppendText() = %q, want %q", got, "prefix:"+string(want))
+	}
+}
+
+func TestAppendTextInvalid(t *testing.T) {
+	var r Rat
+	if _, err := r.AppendText(nil); err == nil {
+		t.Fatal("expected error for invalid Rat")
+	}
+}
@@ -0,0 +1,64 @@
+package zerorat
+
+// Approximate returns the best rational approximation of r whose denominator does not exceed
+// maxDenom, using the continued-fraction convergent algorithm (the same method math/big.Rat's
+// continued-fraction helpers and most "best rational approximation" libraries use).
+// If r already has a denominator <= maxDenom, r.Reduced() is returned unchanged.
+// Returns an invalid Rat if r is invalid or maxDenom is zero.
+func (r Rat) Approximate(maxDenom uint64) Rat {
+	if r.IsInvalid() || maxDenom == 0 {
+		return Rat{}
+	}
+
+	reduced := r.Reduced()
+	if reduced.denominator <= maxDenom {
+		return reduced
+	}
+
+	neg := reduced.numerator < 0
+	n := absInt64ToUint64(reduced.numerator)
+	d := reduced.denominator
+
+	// Convergent recurrence: h[-1]=1, h[-2]=0, k[-1]=0, k[-2]=1.
+	var h0, h1 uint64 = 0, 1
+	var k0, k1 uint64 = 1, 0
+
+	for d != 0 {
+		a := n / d
+		n, d = d, n%d
+
+		hNext := a*h1 + h0
+		kNext := a*k1 + k0
+		if kNext > maxDenom {
+			// The next convergent overflows the bound; try the best semiconvergent between
+			// the previous two convergents, then stop.
+			if k1 == 0 {
+				break
+			}
+			aMax := (maxDenom - k0) / k1
+			if aMax >= a {
+				h0, h1 = h1, a*h1+h0
+				k0, k1 = k1, a*k1+k0
+				break
+			}
+			// Take the semiconvergent with aMax when aMax is at least half of a (rounded up);
+			// otherwise keep the last convergent.
+			if aMax >= (a+1)/2 {
+				h1, k1 = aMax*h1+h0, aMax*k1+k0
+			}
+			break
+		}
+
+		h0, h1 = h1, hNext
+		k0, k1 = k1, kNext
+	}
+
+	num, ok := uint64ToInt64WithSign(h1, neg)
+	if !ok {
+		return Rat{}
+	}
+	if k1 == 0 {
+		return Rat{}
+	}
+	return New(num, k1)
+}
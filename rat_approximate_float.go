@@ -0,0 +1,12 @@
+package zerorat
+
+// ApproximateFloat64 returns the best rational approximation of value whose denominator does
+// not exceed maxDenom, combining NewFromFloat64Rounded and Approximate in one call for callers
+// converting directly from a float64.
+func ApproximateFloat64(value float64, maxDenom uint64) Rat {
+	r := NewFromFloat64Rounded(value, RoundHalfEven)
+	if r.IsInvalid() {
+		return Rat{}
+	}
+	return r.Approximate(maxDenom)
+}
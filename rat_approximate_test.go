@@ -0,0 +1,34 @@
+package zerorat
+
+import "testing"
+
+func TestApproximate(t *testing.T) {
+	cases := []struct {
+		num, den uint64
+		maxDenom uint64
+		want     string
+	}{
+		{355, 113, 100, "311/99"}, // semiconvergent between 22/7 and 355/113, capped below 113
+		{1, 3, 10, "1/3"},       // already within bound
+		{22, 7, 1000, "22/7"},   // unchanged
+	}
+	for _, c := range cases {
+		r := New(int64(c.num), c.den) //nolint:gosec // test fixtures fit int64
+		got := r.Approximate(c.maxDenom)
+		if got.String() != c.want {
+			t.Errorf("New(%d,%d).Approximate(%d) = %q, want %q", c.num, c.den, c.maxDenom, got.String(), c.want)
+		}
+	}
+}
+
+func TestNewFromFloat64CappedAgreesWithApproximate(t *testing.T) {
+	values := []float64{0.3333333333, 3.14159265358979, -2.71828182845905, 0.1}
+	for _, v := range values {
+		viaConvergents := ApproximateFloat64(v, 1000)
+		viaSternBrocot := NewFromFloat64Capped(v, 1000)
+		if !viaConvergents.Equal(viaSternBrocot) {
+			t.Errorf("ApproximateFloat64(%v) = %v, NewFromFloat64Capped(%v) = %v; want equal",
+				v, viaConvergents, v, viaSternBrocot)
+		}
+	}
+}
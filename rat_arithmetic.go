@@ -1,8 +1,6 @@
 package zerorat
 
-import (
-	"math"
-)
+import "math"
 
 // addSubCommon implements common logic for addition and subtraction.
 // isAdd=true for addition, isAdd=false for subtraction.
@@ -127,19 +125,59 @@ func (r *Rat) Mul(other Rat) {
 	}
 
 	// Check numerator multiplication overflow
-	if willOverflowInt64Mul(r.numerator, other.numerator) {
-		r.Invalidate()
+	if !willOverflowInt64Mul(r.numerator, other.numerator) && !willOverflowUint64Mul(r.denominator, other.denominator) {
+		newNum := r.numerator * other.numerator
+		newDenom := r.denominator * other.denominator
+
+		// If result is zero, normalize to 0/1
+		if newNum == 0 {
+			r.numerator = 0
+			r.denominator = 1
+			return
+		}
+
+		// Store result without automatic reduction
+		r.numerator = newNum
+		r.denominator = newDenom
 		return
 	}
 
-	// Check denominator multiplication overflow
-	if willOverflowUint64Mul(r.denominator, other.denominator) {
+	// The literal product overflows. Cross-cancel common factors between each numerator and the
+	// other's denominator before multiplying (a/b * c/d == (a/g1)/(... ) etc.), so products that
+	// would overflow as raw cross-products frequently fit once reduced. This only kicks in on the
+	// overflow path, so the common case above still returns the fully unreduced product.
+	selfNumAbs := absInt64ToUint64(r.numerator)
+	otherNumAbs := absInt64ToUint64(other.numerator)
+	selfDenom := r.denominator
+	otherDenom := other.denominator
+
+	if g := gcdUint64(selfNumAbs, otherDenom); g > 1 {
+		selfNumAbs /= g
+		otherDenom /= g
+	}
+	if g := gcdUint64(otherNumAbs, selfDenom); g > 1 {
+		otherNumAbs /= g
+		selfDenom /= g
+	}
+
+	resultNeg := (r.numerator < 0) != (other.numerator < 0)
+
+	numProd, ok := mulUint64Checked(selfNumAbs, otherNumAbs)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+	denProd, ok := mulUint64Checked(selfDenom, otherDenom)
+	if !ok {
 		r.Invalidate()
 		return
 	}
 
-	newNum := r.numerator * other.numerator
-	newDenom := r.denominator * other.denominator
+	newNum, ok := uint64ToInt64WithSign(numProd, resultNeg)
+	if !ok {
+		r.Invalidate()
+		return
+	}
 
 	// If result is zero, normalize to 0/1
 	if newNum == 0 {
@@ -150,7 +188,7 @@ func (r *Rat) Mul(other Rat) {
 
 	// Store result without automatic reduction
 	r.numerator = newNum
-	r.denominator = newDenom
+	r.denominator = denProd
 }
 
 // Div divides the current rational number by another (mutable operation).
@@ -177,29 +215,66 @@ func (r *Rat) Div(other Rat) {
 	otherNumAbs := absInt64ToUint64(other.numerator)
 
 	// Check for numerator * denominator overflow and compute safely
-	prodNum, ok := mulInt64ByUint64ToInt64(r.numerator, other.denominator)
+	if prodNum, ok := mulInt64ByUint64ToInt64(r.numerator, other.denominator); ok && !willOverflowUint64Mul(r.denominator, otherNumAbs) {
+		newDenom := r.denominator * otherNumAbs
+
+		// Apply sign: if other.numerator was negative, negate result
+		newNum := prodNum
+		if other.numerator < 0 {
+			if newNum == math.MinInt64 {
+				// cannot negate MinInt64 safely; treat as overflow
+				r.Invalidate()
+				return
+			}
+			newNum = -newNum
+		}
+
+		// If result is zero, normalize to 0/1
+		if newNum == 0 {
+			r.numerator = 0
+			r.denominator = 1
+			return
+		}
+
+		// Store result without automatic reduction
+		r.numerator = newNum
+		r.denominator = newDenom
+		return
+	}
+
+	// The literal cross-product overflows. Since a/b ÷ c/d == (a*d)/(b*c), cancel common factors
+	// between the two numerators (a, c) and between the two denominators (b, d) before
+	// multiplying. This only kicks in on the overflow path, so the common case above still returns
+	// the fully unreduced quotient.
+	selfNumAbs := absInt64ToUint64(r.numerator)
+	selfDenom := r.denominator
+	otherDenom := other.denominator
+
+	if g := gcdUint64(selfNumAbs, otherNumAbs); g > 1 {
+		selfNumAbs /= g
+		otherNumAbs /= g
+	}
+	if g := gcdUint64(selfDenom, otherDenom); g > 1 {
+		selfDenom /= g
+		otherDenom /= g
+	}
+
+	numProd, ok := mulUint64Checked(selfNumAbs, otherDenom)
 	if !ok {
 		r.Invalidate()
 		return
 	}
-
-	// Check for denominator * numerator overflow
-	if willOverflowUint64Mul(r.denominator, otherNumAbs) {
+	denProd, ok := mulUint64Checked(selfDenom, otherNumAbs)
+	if !ok {
 		r.Invalidate()
 		return
 	}
 
-	newNum := prodNum
-	newDenom := r.denominator * otherNumAbs
-
-	// Apply sign: if other.numerator was negative, negate result
-	if other.numerator < 0 {
-		if newNum == math.MinInt64 {
-			// cannot negate MinInt64 safely; treat as overflow
-			r.Invalidate()
-			return
-		}
-		newNum = -newNum
+	resultNeg := (r.numerator < 0) != (other.numerator < 0)
+	newNum, ok := uint64ToInt64WithSign(numProd, resultNeg)
+	if !ok {
+		r.Invalidate()
+		return
 	}
 
 	// If result is zero, normalize to 0/1
@@ -211,7 +286,7 @@ func (r *Rat) Div(other Rat) {
 
 	// Store result without automatic reduction
 	r.numerator = newNum
-	r.denominator = newDenom
+	r.denominator = denProd
 }
 
 // Divided returns the quotient of current divided by another rational number (immutable operation).
@@ -0,0 +1,35 @@
+package zerorat
+
+import "math/bits"
+
+// mul128 multiplies two uint64 values, returning the full 128-bit product as (hi, lo).
+// Thin wrapper over bits.Mul64 kept here so callers read as "128-bit arithmetic" rather than
+// reaching for math/bits directly at each call site.
+func mul128(a, b uint64) (hi, lo uint64) {
+	return bits.Mul64(a, b)
+}
+
+// div128 divides the 128-bit numerator (hi, lo) by den, returning the quotient and remainder.
+// Panics (via bits.Div64) if the quotient would overflow 64 bits; callers must ensure hi < den.
+func div128(hi, lo, den uint64) (quo, rem uint64) {
+	return bits.Div64(hi, lo, den)
+}
+
+// fitsUint32 reports whether v fits in 32 bits, used as a fast-path gate to skip the 128-bit
+// path entirely for the common case of small operands.
+func fitsUint32(v uint64) bool {
+	return v <= 0xFFFFFFFF
+}
+
+// mulUint64Checked multiplies a and b and reports whether the full 128-bit product actually
+// fits in 64 bits, computing via the branch-predictor-friendly 32-bit fast path first.
+func mulUint64Checked(a, b uint64) (result uint64, ok bool) {
+	if fitsUint32(a) && fitsUint32(b) {
+		return a * b, true
+	}
+	hi, lo := mul128(a, b)
+	if hi != 0 {
+		return 0, false
+	}
+	return lo, true
+}
@@ -0,0 +1,20 @@
+package zerorat
+
+import "math"
+
+// BestApproximation finds the best rational approximation of value with a denominator no larger
+// than maxDenom, preferring the continued-fraction convergent search (ApproximateFloat64) and
+// falling back to the Stern-Brocot mediant search (NewFromFloat64Capped) if the former returns an
+// invalid Rat. NaN and ±Inf are rejected up front with an invalid Rat, before either search runs.
+// The two algorithms agree on every normal input; this only matters for edge cases.
+func BestApproximation(value float64, maxDenom uint64) Rat {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Rat{}
+	}
+
+	result := ApproximateFloat64(value, maxDenom)
+	if result.IsValid() {
+		return result
+	}
+	return NewFromFloat64Capped(value, maxDenom)
+}
@@ -0,0 +1,20 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBestApproximationMatchesContinuedFraction(t *testing.T) {
+	got := BestApproximation(0.333333, 1000)
+	want := ApproximateFloat64(0.333333, 1000)
+	if !got.Equal(want) {
+		t.Errorf("BestApproximation() = %v, want %v", got, want)
+	}
+}
+
+func TestBestApproximationRejectsNaNAndInf(t *testing.T) {
+	if got := BestApproximation(math.NaN(), 100); got.IsValid() {
+		t.Errorf("BestApproximation(NaN) = %v, want invalid", got)
+	}
+}
@@ -0,0 +1,109 @@
+package zerorat
+
+import "math/big"
+
+// BigRat converts r to a *big.Rat. Returns nil for an invalid Rat.
+func (r Rat) BigRat() *big.Rat {
+	if r.IsInvalid() {
+		return nil
+	}
+	return new(big.Rat).SetFrac(big.NewInt(r.numerator), new(big.Int).SetUint64(r.denominator))
+}
+
+// fromBigRat converts a *big.Rat back into a Rat, invalidating if the reduced numerator or
+// denominator doesn't fit in int64/uint64.
+func fromBigRat(br *big.Rat) Rat {
+	num := br.Num()
+	den := br.Denom()
+	if !num.IsInt64() || !den.IsUint64() {
+		return Rat{}
+	}
+	return New(num.Int64(), den.Uint64())
+}
+
+// AddFallback adds other to r the same as Add, except that on int64/uint64 overflow it retries
+// the computation with math/big instead of invalidating, succeeding as long as the final reduced
+// numerator and denominator each fit back into int64/uint64.
+func (r *Rat) AddFallback(other Rat) {
+	lhs := *r
+	r.Add(other)
+	if r.IsInvalid() && lhs.IsValid() && other.IsValid() {
+		sum := new(big.Rat).Add(lhs.BigRat(), other.BigRat())
+		*r = fromBigRat(sum)
+	}
+}
+
+// SubFallback subtracts other from r the same as Sub, falling back to math/big on overflow; see
+// AddFallback.
+func (r *Rat) SubFallback(other Rat) {
+	lhs := *r
+	r.Sub(other)
+	if r.IsInvalid() && lhs.IsValid() && other.IsValid() {
+		diff := new(big.Rat).Sub(lhs.BigRat(), other.BigRat())
+		*r = fromBigRat(diff)
+	}
+}
+
+// MulFallback multiplies r by other the same as Mul, falling back to math/big on overflow; see
+// AddFallback.
+func (r *Rat) MulFallback(other Rat) {
+	lhs := *r
+	r.Mul(other)
+	if r.IsInvalid() && lhs.IsValid() && other.IsValid() {
+		prod := new(big.Rat).Mul(lhs.BigRat(), other.BigRat())
+		*r = fromBigRat(prod)
+	}
+}
+
+// DivFallback divides r by other the same as Div, falling back to math/big on overflow; see
+// AddFallback.
+func (r *Rat) DivFallback(other Rat) {
+	lhs := *r
+	r.Div(other)
+	if r.IsInvalid() && lhs.IsValid() && other.IsValid() && !other.IsZero() {
+		quo := new(big.Rat).Quo(lhs.BigRat(), other.BigRat())
+		*r = fromBigRat(quo)
+	}
+}
+
+// PowFallback raises r to the integer power n the same as Pow, falling back to math/big on
+// overflow instead of invalidating; see AddFallback.
+func (r *Rat) PowFallback(n int) {
+	lhs := *r
+	r.Pow(n)
+	if r.IsInvalid() && lhs.IsValid() && !(n < 0 && lhs.IsZero()) {
+		neg := n < 0
+		absN := n
+		if neg {
+			absN = -n
+		}
+		base := lhs.BigRat()
+		if neg {
+			base = new(big.Rat).Inv(base)
+		}
+		result := new(big.Rat).SetInt64(1)
+		for i := 0; i < absN; i++ {
+			result.Mul(result, base)
+		}
+		*r = fromBigRat(result)
+	}
+}
+
+// NewFromBigRat converts a *big.Rat into a Rat, returning the invalid sentinel if br is nil or
+// its reduced numerator/denominator don't fit in int64/uint64.
+func NewFromBigRat(br *big.Rat) Rat {
+	if br == nil {
+		return Rat{}
+	}
+	return fromBigRat(br)
+}
+
+// CompareFallback compares r and other exactly using math/big, bypassing the int64/uint64 cross-
+// multiplication path entirely. Useful when callers already suspect operands are too large for
+// Compare's fast path to have computed a meaningful answer.
+func (r Rat) CompareFallback(other Rat) int {
+	if r.IsInvalid() || other.IsInvalid() {
+		return r.Compare(other)
+	}
+	return r.BigRat().Cmp(other.BigRat())
+}
@@ -0,0 +1,41 @@
+package zerorat
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrRatBinaryLength indicates a byte slice passed to UnmarshalBinary had the wrong length.
+var ErrRatBinaryLength = errors.New("zerorat: invalid binary length for Rat")
+
+// ratBinaryLen is the fixed wire size of MarshalBinary's output: int64 numerator + uint64 denominator.
+const ratBinaryLen = 16
+
+// MarshalBinary implements encoding.BinaryMarshaler, encoding numerator and denominator as two
+// fixed-width big-endian integers (16 bytes total).
+func (r Rat) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, ratBinaryLen)
+	binary.BigEndian.PutUint64(buf[0:8], uint64(r.numerator)) //nolint:gosec // reinterpreting bits, not a value conversion
+	binary.BigEndian.PutUint64(buf[8:16], r.denominator)
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler for the format written by MarshalBinary.
+func (r *Rat) UnmarshalBinary(data []byte) error {
+	if len(data) != ratBinaryLen {
+		return ErrRatBinaryLength
+	}
+	r.numerator = int64(binary.BigEndian.Uint64(data[0:8])) //nolint:gosec // reinterpreting bits
+	r.denominator = binary.BigEndian.Uint64(data[8:16])
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder by delegating to MarshalBinary.
+func (r Rat) GobEncode() ([]byte, error) {
+	return r.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder by delegating to UnmarshalBinary.
+func (r *Rat) GobDecode(data []byte) error {
+	return r.UnmarshalBinary(data)
+}
@@ -0,0 +1,34 @@
+package zerorat
+
+import "encoding/binary"
+
+// AppendCompactBinary appends r's compact wire form to buf and returns the extended slice. Unlike
+// MarshalBinary's fixed 16-byte form, this uses zigzag+varint encoding for the numerator and
+// varint for the denominator, so small values (the common case) take far fewer bytes.
+func (r Rat) AppendCompactBinary(buf []byte) []byte {
+	zigzag := uint64(r.numerator<<1) ^ uint64(r.numerator>>63) //nolint:gosec // standard zigzag encoding
+	buf = binary.AppendUvarint(buf, zigzag)
+	buf = binary.AppendUvarint(buf, r.denominator)
+	return buf
+}
+
+// CompactBinary returns r's compact wire form; see AppendCompactBinary.
+func (r Rat) CompactBinary() []byte {
+	return r.AppendCompactBinary(nil)
+}
+
+// FromCompactBinary decodes a Rat from the format written by AppendCompactBinary/CompactBinary,
+// returning the number of bytes consumed from data. Returns invalid Rat and 0 consumed on
+// malformed input.
+func FromCompactBinary(data []byte) (Rat, int) {
+	zigzag, n1 := binary.Uvarint(data)
+	if n1 <= 0 {
+		return Rat{}, 0
+	}
+	denom, n2 := binary.Uvarint(data[n1:])
+	if n2 <= 0 {
+		return Rat{}, 0
+	}
+	num := int64(zigzag>>1) ^ -int64(zigzag&1) //nolint:gosec // standard zigzag decoding
+	return Rat{numerator: num, denominator: denom}, n1 + n2
+}
@@ -0,0 +1,31 @@
+package zerorat
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestRatGobRoundTrip(t *testing.T) {
+	want := New(-7, 3)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob Encode: %v", err)
+	}
+
+	var got Rat
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob Decode: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("gob round trip = %v, want %v", got, want)
+	}
+}
+
+func TestRatMarshalBinaryInvalidLength(t *testing.T) {
+	var r Rat
+	if err := r.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("UnmarshalBinary with short data expected error, got nil")
+	}
+}
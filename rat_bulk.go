@@ -0,0 +1,140 @@
+package zerorat
+
+// SumRats returns the sum of a slice of Rat values. Returns Zero() for an empty slice and an
+// invalid Rat as soon as any element is invalid or the running sum overflows.
+func SumRats(values []Rat) Rat {
+	result := Zero()
+	for _, v := range values {
+		result.Add(v)
+		if result.IsInvalid() {
+			return Rat{}
+		}
+	}
+	return result
+}
+
+// DotRats computes the dot product of two equal-length Rat slices (sum of element-wise
+// products). Returns an invalid Rat if the slices differ in length or any element is invalid.
+func DotRats(a, b []Rat) Rat {
+	if len(a) != len(b) {
+		return Rat{}
+	}
+	result := Zero()
+	for i := range a {
+		term := a[i]
+		term.Mul(b[i])
+		result.Add(term)
+		if result.IsInvalid() {
+			return Rat{}
+		}
+	}
+	return result
+}
+
+// ScaleRats multiplies every element of values by factor, returning a new slice of the same
+// length. An invalid element or overflow produces an invalid Rat in that position.
+func ScaleRats(values []Rat, factor Rat) []Rat {
+	result := make([]Rat, len(values))
+	for i, v := range values {
+		v.Mul(factor)
+		result[i] = v
+	}
+	return result
+}
+
+// ProductRats returns the product of a slice of Rat values. Returns One() for an empty slice and
+// an invalid Rat as soon as any element is invalid or the running product overflows.
+func ProductRats(values []Rat) Rat {
+	result := One()
+	for _, v := range values {
+		result.Mul(v)
+		if result.IsInvalid() {
+			return Rat{}
+		}
+	}
+	return result
+}
+
+// AvgRats returns the arithmetic mean of values. Returns an invalid Rat for an empty slice, any
+// invalid element, or overflow.
+func AvgRats(values []Rat) Rat {
+	if len(values) == 0 {
+		return Rat{}
+	}
+	sum := SumRats(values)
+	if sum.IsInvalid() {
+		return Rat{}
+	}
+	sum.Div(NewFromInt(int64(len(values))))
+	return sum
+}
+
+// MinRats returns the smallest value in values. Returns an invalid Rat for an empty slice or if
+// any element is invalid.
+func MinRats(values []Rat) Rat {
+	if len(values) == 0 {
+		return Rat{}
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		if v.IsInvalid() || result.IsInvalid() {
+			return Rat{}
+		}
+		if v.Less(result) {
+			result = v
+		}
+	}
+	if result.IsInvalid() {
+		return Rat{}
+	}
+	return result
+}
+
+// MaxRats returns the largest value in values. Returns an invalid Rat for an empty slice or if
+// any element is invalid.
+func MaxRats(values []Rat) Rat {
+	if len(values) == 0 {
+		return Rat{}
+	}
+	result := values[0]
+	for _, v := range values[1:] {
+		if v.IsInvalid() || result.IsInvalid() {
+			return Rat{}
+		}
+		if result.Less(v) {
+			result = v
+		}
+	}
+	if result.IsInvalid() {
+		return Rat{}
+	}
+	return result
+}
+
+// DiffRats returns the element-wise successive differences of values (values[i+1] - values[i]),
+// one entry shorter than the input. Returns nil for a slice of fewer than two elements.
+func DiffRats(values []Rat) []Rat {
+	if len(values) < 2 {
+		return nil
+	}
+	result := make([]Rat, len(values)-1)
+	for i := 1; i < len(values); i++ {
+		d := values[i]
+		d.Sub(values[i-1])
+		result[i-1] = d
+	}
+	return result
+}
+
+// CumSumRats returns the running (cumulative) sum of values, one entry per input element.
+// Once the running sum becomes invalid (overflow or invalid element), every subsequent entry
+// is also invalid.
+func CumSumRats(values []Rat) []Rat {
+	result := make([]Rat, len(values))
+	running := Zero()
+	for i, v := range values {
+		running.Add(v)
+		result[i] = running
+	}
+	return result
+}
@@ -0,0 +1,27 @@
+package zerorat
+
+// AddOk adds r and other and reports success, mirroring the (result, ok) shape of the proposed
+// math/overflow package rather than Rat's usual invalidate-in-place convention. Equivalent to
+// r.Added(other) plus an explicit validity check.
+func (r Rat) AddOk(other Rat) (Rat, bool) {
+	result := r.Added(other)
+	return result, result.IsValid()
+}
+
+// SubOk subtracts other from r and reports success; see AddOk.
+func (r Rat) SubOk(other Rat) (Rat, bool) {
+	result := r.Subtracted(other)
+	return result, result.IsValid()
+}
+
+// MulOk multiplies r by other and reports success; see AddOk.
+func (r Rat) MulOk(other Rat) (Rat, bool) {
+	result := r.Multiplied(other)
+	return result, result.IsValid()
+}
+
+// DivOk divides r by other and reports success; see AddOk.
+func (r Rat) DivOk(other Rat) (Rat, bool) {
+	result := r.Divided(other)
+	return result, result.IsValid()
+}
@@ -0,0 +1,49 @@
+package zerorat
+
+// ContinuedFractionTerms expands r into its continued-fraction terms [a0; a1, a2, ...], the same
+// recurrence Approximate uses internally but exposed directly for callers who want the terms
+// themselves (e.g. to detect periodicity or build a custom convergent). Returns nil for an
+// invalid Rat. For a negative r the terms of |r| are all negated, so -7/2 gives [-3; -2].
+func (r Rat) ContinuedFractionTerms() []int64 {
+	if r.IsInvalid() {
+		return nil
+	}
+
+	reduced := r.Reduced()
+	neg := reduced.numerator < 0
+	n := absInt64ToUint64(reduced.numerator)
+	d := reduced.denominator
+
+	var terms []int64
+	for d != 0 {
+		a := int64(n / d) //nolint:gosec // a is bounded by the original numerator
+		n, d = d, n%d
+		if neg {
+			a = -a
+		}
+		terms = append(terms, a)
+	}
+	return terms
+}
+
+// FromContinuedFraction reconstructs the exact Rat represented by continued-fraction terms
+// [a0; a1, a2, ...], as produced by ContinuedFractionTerms. Returns an invalid Rat for an empty
+// terms slice or on overflow.
+func FromContinuedFraction(terms []int64) Rat {
+	if len(terms) == 0 {
+		return Rat{}
+	}
+
+	result := NewFromInt(terms[len(terms)-1])
+	for i := len(terms) - 2; i >= 0; i-- {
+		if result.IsInvalid() || result.IsZero() {
+			return Rat{}
+		}
+		result.Inv()
+		result.Add(NewFromInt(terms[i]))
+		if result.IsInvalid() {
+			return Rat{}
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package zerorat
+
+import "testing"
+
+func TestInvalidRatRoundTripsThroughText(t *testing.T) {
+	var r Rat
+	r.Invalidate()
+
+	data, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() on invalid Rat: %v", err)
+	}
+	if len(data) != 0 {
+		t.Errorf("MarshalText() on invalid Rat = %q, want empty", data)
+	}
+
+	got := New(1, 2)
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText(%q): %v", data, err)
+	}
+	if got.IsValid() {
+		t.Errorf("UnmarshalText(%q) = %v, want invalid", data, got)
+	}
+}
+
+func TestInvalidRatRoundTripsThroughJSON(t *testing.T) {
+	var r Rat
+	r.Invalidate()
+
+	data, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() on invalid Rat: %v", err)
+	}
+	if string(data) != "null" {
+		t.Errorf("MarshalJSON() on invalid Rat = %q, want %q", data, "null")
+	}
+
+	got := New(1, 2)
+	if err := got.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON(%q): %v", data, err)
+	}
+	if got.IsValid() {
+		t.Errorf("UnmarshalJSON(%q) = %v, want invalid", data, got)
+	}
+}
+
+func FuzzJSONRoundTrip(f *testing.F) {
+	f.Add(int64(3), uint64(4))
+	f.Add(int64(0), uint64(1))
+	f.Add(int64(-7), uint64(9))
+
+	f.Fuzz(func(t *testing.T, num int64, den uint64) {
+		if den == 0 {
+			t.Skip("zero denominator is the invalid sentinel, not a round-trip case")
+		}
+		x := New(num, den)
+		if x.IsInvalid() {
+			t.Skip("New rejected this pair")
+		}
+
+		data, err := x.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%v): %v", x, err)
+		}
+		var got Rat
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%q): %v", data, err)
+		}
+		if !got.Equal(x) {
+			t.Errorf("JSON round trip %v -> %q -> %v", x, data, got)
+		}
+	})
+}
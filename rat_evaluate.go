@@ -0,0 +1,30 @@
+package zerorat
+
+// Evaluate rounds r to an integer using roundType and returns it as an int64, together with
+// whether the conversion succeeded (r was valid and the rounded value fits in int64).
+func (r Rat) Evaluate(roundType RoundType) (int64, bool) {
+	if r.IsInvalid() {
+		return 0, false
+	}
+	rounded := r.Rounded(roundType, 0)
+	if rounded.IsInvalid() {
+		return 0, false
+	}
+	return rounded.numerator, true
+}
+
+// TruncInt64 truncates r toward zero and returns it as an int64, together with whether r was
+// valid. Equivalent to Evaluate(RoundDown) but named for the common "just give me an int" case.
+func (r Rat) TruncInt64() (int64, bool) {
+	return r.Evaluate(RoundDown)
+}
+
+// TruncUint64 truncates r toward zero and returns it as a uint64, failing if r is invalid or
+// negative.
+func (r Rat) TruncUint64() (uint64, bool) {
+	v, ok := r.TruncInt64()
+	if !ok || v < 0 {
+		return 0, false
+	}
+	return uint64(v), true
+}
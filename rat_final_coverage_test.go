@@ -1,101 +0,0 @@
-package zerorat
-
-import (
-	"math"
-	"testing"
-
-	"github.com/stretchr/testify/assert"
-)
-
-// Test_CoverSpecificLines targets the exact uncovered lines mentioned
-func Test_CoverSpecificLines(t *testing.T) {
-	// Test line 676: return Rat{} for NaN/Inf in float64ToRatExact
-	// This should be covered by existing tests, but let's be explicit
-	rNaN := NewFromFloat64(math.NaN())
-	assert.True(t, rNaN.IsInvalid())
-
-	// Test line 680: return Rat{numerator: 0, denominator: 1} for zero
-	rZero := NewFromFloat64(0.0)
-	assert.Equal(t, int64(0), rZero.numerator)
-	assert.Equal(t, uint64(1), rZero.denominator)
-
-	// Test line 749: return Rat{} for overflow in e>=0 path
-	// Create a value that will trigger the overflow condition
-	// We need newShift < 0 or newShift >= 64 or mant > (absLimit>>newShift)
-	veryLarge := math.Ldexp(1.0, 1024) // This should be infinity, but let's try a large finite value
-	if !math.IsInf(veryLarge, 0) {
-		rLarge := NewFromFloat64(veryLarge)
-		// Should either be invalid or valid, but this exercises the path
-		_ = rLarge.IsValid()
-	}
-
-	// Try a more targeted approach: create a float with large mantissa and exponent
-	// that would cause overflow in the shifting logic
-	largeMantissa := math.Ldexp(1.0, 52)       // 2^52, maximum mantissa
-	largeExp := math.Ldexp(largeMantissa, 100) // Should be very large
-	rOverflow := NewFromFloat64(largeExp)
-	// This should trigger the overflow path and return invalid
-	if rOverflow.IsInvalid() {
-		assert.Equal(t, uint64(0), rOverflow.denominator)
-	}
-
-	// Test line 755: n = -n (negation in e>=0 path)
-	// Need a negative value that successfully goes through the e>=0 path
-	negPowerOf2 := -math.Ldexp(1.0, 50) // -2^50, should be representable
-	rNegative := NewFromFloat64(negPowerOf2)
-	if rNegative.IsValid() {
-		assert.Negative(t, rNegative.numerator)
-	}
-}
-
-// Test_ReduceUncoveredLines targets uncovered lines in Reduce function
-func Test_ReduceUncoveredLines(t *testing.T) {
-	// The Reduce function has some uncovered lines, likely in edge cases
-	// Let's try to trigger the "Should not happen" case in line 457-459
-
-	// Create a rational that might trigger the overflow case in uint64ToInt64WithSign
-	// This is tricky because the function is designed to be safe, but let's try
-
-	// Test with a very large numerator that after GCD reduction might cause issues
-	// This is hard to trigger because the function is well-designed, but let's try edge cases
-
-	// Try MinInt64 case which might have special handling
-	r := Rat{numerator: math.MinInt64, denominator: 2}
-	r.Reduce()
-	// Should reduce to MinInt64/2, 1 if possible, or handle the MinInt64 special case
-	assert.True(t, r.IsValid() || r.IsInvalid()) // Either outcome is acceptable
-
-	// Try a case where GCD is large and might cause issues
-	r2 := Rat{numerator: math.MinInt64, denominator: uint64(math.MaxInt64) + 1}
-	r2.Reduce()
-	assert.True(t, r2.IsValid() || r2.IsInvalid()) // Either outcome is acceptable
-}
-
-// Test_Float64SpecialCases covers remaining float64ToRatExact edge cases
-func Test_Float64SpecialCases(t *testing.T) {
-	// Test subnormal numbers (expBits == 0) more thoroughly
-	subnormal := math.SmallestNonzeroFloat64
-	rSubnormal := NewFromFloat64(subnormal)
-	assert.True(t, rSubnormal.IsValid())
-
-	// Test the largest finite float64
-	largest := math.MaxFloat64
-	rLargest := NewFromFloat64(largest)
-	// This might be invalid due to overflow, which is correct behavior
-	_ = rLargest.IsValid()
-
-	// Test values that might trigger specific rounding behavior
-	// Values that create exact ties in rounding
-	val1 := math.Ldexp(3.0, -2) // 3/4, should be exact
-	r1 := NewFromFloat64(val1)
-	assert.True(t, r1.IsValid())
-	assert.Equal(t, int64(3), r1.numerator)
-	assert.Equal(t, uint64(4), r1.denominator)
-
-	// Test negative zero using bit manipulation
-	negZero := math.Float64frombits(1 << 63) // -0.0
-	rNegZero := NewFromFloat64(negZero)
-	assert.True(t, rNegZero.IsValid())
-	assert.Equal(t, int64(0), rNegZero.numerator)
-	assert.Equal(t, uint64(1), rNegZero.denominator)
-}
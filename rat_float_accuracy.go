@@ -0,0 +1,91 @@
+package zerorat
+
+import "math/big"
+
+// Accuracy describes how a rounded float64 conversion compares to the exact value, matching the
+// three-value shape of math/big.Accuracy.
+type Accuracy int8
+
+const (
+	// Below means the returned float64 is strictly less than the exact value.
+	Below Accuracy = -1
+	// Exact means the returned float64 represents the exact value.
+	Exact Accuracy = 0
+	// Above means the returned float64 is strictly greater than the exact value.
+	Above Accuracy = 1
+)
+
+// String renders the Accuracy value for debugging/logging.
+func (a Accuracy) String() string {
+	switch a {
+	case Below:
+		return "below"
+	case Exact:
+		return "exact"
+	case Above:
+		return "above"
+	default:
+		return "unknown"
+	}
+}
+
+// Float64Accuracy behaves like Float64 but reports which direction the rounding went instead of
+// just whether it was exact, for callers that need to compensate (e.g. always round the same way
+// to keep a running sum from drifting).
+func (r Rat) Float64Accuracy() (f float64, acc Accuracy) {
+	f, exact := r.Float64()
+	if exact {
+		return f, Exact
+	}
+
+	back := float64ToRatExact(f)
+	if back.IsInvalid() {
+		// f's exact value does not fit in a Rat (|f| >= 2^63, or f needs a denominator above
+		// 2^63), or f is NaN because r is invalid. A finite f is compared through math/big.
+		if r.IsValid() {
+			return f, Accuracy(new(big.Rat).SetFloat64(f).Cmp(r.BigRat()))
+		}
+		if r.numerator < 0 {
+			return f, Below
+		}
+		return f, Above
+	}
+
+	switch {
+	case back.Less(r):
+		return f, Below
+	case r.Less(back):
+		return f, Above
+	default:
+		return f, Exact
+	}
+}
+
+// Float32Accuracy behaves like Float32 but reports which direction the rounding went, mirroring
+// Float64Accuracy at float32 precision.
+func (r Rat) Float32Accuracy() (f float32, acc Accuracy) {
+	f, exact := r.Float32()
+	if exact {
+		return f, Exact
+	}
+
+	back := float64ToRatExact(float64(f))
+	if back.IsInvalid() {
+		if r.IsValid() {
+			return f, Accuracy(new(big.Rat).SetFloat64(float64(f)).Cmp(r.BigRat()))
+		}
+		if r.numerator < 0 {
+			return f, Below
+		}
+		return f, Above
+	}
+
+	switch {
+	case back.Less(r):
+		return f, Below
+	case r.Less(back):
+		return f, Above
+	default:
+		return f, Exact
+	}
+}
@@ -0,0 +1,27 @@
+package zerorat
+
+import "testing"
+
+func TestFloat64AccuracyExact(t *testing.T) {
+	r := New(1, 4)
+	f, acc := r.Float64Accuracy()
+	if acc != Exact || f != 0.25 {
+		t.Errorf("Float64Accuracy(1/4) = (%v, %v), want (0.25, Exact)", f, acc)
+	}
+}
+
+func TestFloat64AccuracyRounded(t *testing.T) {
+	r := New(1, 3)
+	_, acc := r.Float64Accuracy()
+	if acc != Below && acc != Above {
+		t.Errorf("Float64Accuracy(1/3) accuracy = %v, want Below or Above", acc)
+	}
+}
+
+func TestFloat32AccuracyExact(t *testing.T) {
+	r := New(1, 2)
+	f, acc := r.Float32Accuracy()
+	if acc != Exact || f != 0.5 {
+		t.Errorf("Float32Accuracy(1/2) = (%v, %v), want (0.5, Exact)", f, acc)
+	}
+}
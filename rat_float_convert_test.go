@@ -1,388 +0,0 @@
-package zerorat
-
-import (
-	"math"
-	"testing"
-
-	"github.com/stretchr/testify/assert"
-)
-
-// Test_float64ToRatExact_Direct tests the internal function directly
-func Test_float64ToRatExact_Direct(t *testing.T) {
-	// Line 676: return Rat{} for NaN
-	rNaN := float64ToRatExact(math.NaN())
-	assert.True(t, rNaN.IsInvalid())
-	assert.Equal(t, int64(0), rNaN.numerator)
-	assert.Equal(t, uint64(0), rNaN.denominator)
-
-	// Line 676: return Rat{} for +Inf
-	rPosInf := float64ToRatExact(math.Inf(1))
-	assert.True(t, rPosInf.IsInvalid())
-	assert.Equal(t, int64(0), rPosInf.numerator)
-	assert.Equal(t, uint64(0), rPosInf.denominator)
-
-	// Line 676: return Rat{} for -Inf
-	rNegInf := float64ToRatExact(math.Inf(-1))
-	assert.True(t, rNegInf.IsInvalid())
-	assert.Equal(t, int64(0), rNegInf.numerator)
-	assert.Equal(t, uint64(0), rNegInf.denominator)
-
-	// Line 680: return Rat{numerator: 0, denominator: 1} for +0.0
-	rZero := float64ToRatExact(0.0)
-	assert.True(t, rZero.IsValid())
-	assert.Equal(t, int64(0), rZero.numerator)
-	assert.Equal(t, uint64(1), rZero.denominator)
-
-	// Line 680: return Rat{numerator: 0, denominator: 1} for -0.0
-	negZero := math.Float64frombits(1 << 63) // -0.0 using bit manipulation
-	rNegZero := float64ToRatExact(negZero)
-	assert.True(t, rNegZero.IsValid())
-	assert.Equal(t, int64(0), rNegZero.numerator)
-	assert.Equal(t, uint64(1), rNegZero.denominator)
-
-	// Test normal values to ensure the function works correctly
-	r1 := float64ToRatExact(0.5)
-	assert.True(t, r1.IsValid())
-	assert.Equal(t, int64(1), r1.numerator)
-	assert.Equal(t, uint64(2), r1.denominator)
-
-	r2 := float64ToRatExact(-0.25)
-	assert.True(t, r2.IsValid())
-	assert.Equal(t, int64(-1), r2.numerator)
-	assert.Equal(t, uint64(4), r2.denominator)
-}
-
-// Test_float64ToRatExact_OverflowCases tests overflow scenarios
-func Test_float64ToRatExact_OverflowCases(t *testing.T) {
-	// Test very large values that should trigger overflow
-	veryLarge := math.Ldexp(1.0, 1000) // 2^1000
-	if math.IsInf(veryLarge, 0) {
-		// If it becomes infinity, test that path
-		rLarge := float64ToRatExact(veryLarge)
-		assert.True(t, rLarge.IsInvalid())
-	} else {
-		// If it's still finite, it should either work or overflow
-		rLarge := float64ToRatExact(veryLarge)
-		// Either valid or invalid is acceptable for such large values
-		_ = rLarge.IsValid()
-	}
-
-	// Test the largest finite float64
-	maxFloat := math.MaxFloat64
-	rMax := float64ToRatExact(maxFloat)
-	// This will likely be invalid due to overflow, which is correct
-	if rMax.IsInvalid() {
-		assert.Equal(t, uint64(0), rMax.denominator)
-	}
-
-	// Test values that might trigger the e>=0 overflow path (line 749)
-	// Create a value with large mantissa and exponent
-	largeMantExp := math.Ldexp(math.Ldexp(1.0, 52), 50) // Very large value
-	rLargeMantExp := float64ToRatExact(largeMantExp)
-	// Should either be valid or trigger overflow
-	_ = rLargeMantExp.IsValid()
-}
-
-// Test_float64ToRatExact_NegationPath tests the negation path (line 755)
-func Test_float64ToRatExact_NegationPath(t *testing.T) {
-	// Test negative values that go through the e>=0 path
-	negValue := -8.0 // -2^3, simple negative power of 2
-	rNeg := float64ToRatExact(negValue)
-	assert.True(t, rNeg.IsValid())
-	assert.Equal(t, int64(-8), rNeg.numerator)
-	assert.Equal(t, uint64(1), rNeg.denominator)
-
-	// Test larger negative value
-	negLarge := -math.Ldexp(1.0, 50) // -2^50
-	rNegLarge := float64ToRatExact(negLarge)
-	if rNegLarge.IsValid() {
-		assert.Negative(t, rNegLarge.numerator)
-		assert.Positive(t, rNegLarge.denominator)
-	}
-}
-
-// Test_float64ToRatExact_Lines749And755 targets lines 749 and 755 exactly
-func Test_float64ToRatExact_Lines749And755(t *testing.T) {
-	// Line 749: return Rat{} when newShift < 0 || newShift >= 64 || mant > (absLimit>>uint(newShift))
-	// To trigger this, we need a value that goes through the offload path but still overflows
-
-	// Create the largest possible finite float64 that might trigger overflow
-	// Use the maximum exponent that doesn't result in infinity
-	maxFiniteExp := 1023 // Unbiased exponent for largest finite values
-	maxMantissa := (uint64(1) << 52) - 1
-
-	// Create float64 with max exponent and max mantissa
-	expBits := uint64(maxFiniteExp + 1023) // Add IEEE bias
-	bits := (expBits << 52) | maxMantissa
-	maxFinite := math.Float64frombits(bits)
-
-	if !math.IsInf(maxFinite, 0) {
-		rMax := float64ToRatExact(maxFinite)
-		// This should trigger line 749 overflow
-		if rMax.IsInvalid() {
-			assert.Equal(t, uint64(0), rMax.denominator)
-		}
-	}
-
-	// Try another approach: create a value with very large mantissa that needs offloading
-	// but the offloading calculation overflows
-	largeValue := math.Ldexp(math.Ldexp(1.0, 52), 500) // Extremely large value
-	if !math.IsInf(largeValue, 0) {
-		rLargeValue := float64ToRatExact(largeValue)
-		if rLargeValue.IsInvalid() {
-			assert.Equal(t, uint64(0), rLargeValue.denominator)
-		}
-	}
-
-	// Line 755: n = -n (negation in e>=0 offload path)
-	// We need a negative value that goes through the offload path (not the fast path)
-	// The offload path happens when the value is too large to fit in the fast path
-
-	// To force the offload path, we need a value where mant << e > absLimit
-	// For negative numbers, absLimit = 2^63, so we need something larger than that
-
-	// Create a negative value that's definitely too large for the fast path
-	// Use a very large mantissa with a moderate exponent
-	largeMantissa := math.Ldexp(1.5, 52)            // 1.5 * 2^52, close to max mantissa
-	largeNegValue := -math.Ldexp(largeMantissa, 15) // Scale it up to force offload
-
-	rNegOffload := float64ToRatExact(largeNegValue)
-	if rNegOffload.IsValid() {
-		assert.Negative(t, rNegOffload.numerator) // This should exercise line 755 in offload path
-		assert.Positive(t, rNegOffload.denominator)
-	}
-
-	// Try a simpler approach: use a value that we know will need offloading
-	// A value like -3 * 2^61 should be too large for fast path but manageable for offload
-	negNeedsOffload := -math.Ldexp(3.0, 61)
-	rNegNeedsOffload := float64ToRatExact(negNeedsOffload)
-	if rNegNeedsOffload.IsValid() {
-		assert.Negative(t, rNegNeedsOffload.numerator) // Line 755
-		assert.Positive(t, rNegNeedsOffload.denominator)
-	}
-
-	// Test with the maximum safe negative value that still needs offloading
-	// Use -2^63 which is exactly at the boundary
-	minInt64Float := float64(math.MinInt64)
-	rMinInt64 := float64ToRatExact(minInt64Float)
-	if rMinInt64.IsValid() {
-		assert.Equal(t, int64(math.MinInt64), rMinInt64.numerator) // Line 755 should be hit
-		assert.Equal(t, uint64(1), rMinInt64.denominator)
-	}
-}
-
-// Test_float64ToRatExact_Branches adds coverage for e>=0 offload and e<0 rounding paths
-func Test_float64ToRatExact_Branches(t *testing.T) {
-	// e >= 0 fast path within range
-	r := NewFromFloat64(8.0) // 8 = 1 * 2^3
-	assert.True(t, r.IsValid())
-	assert.Equal(t, int64(8), r.numerator)
-	assert.Equal(t, uint64(1), r.denominator)
-
-	// e < 0 rounding: value where rounding is tie and base is even/odd
-	r2 := NewFromFloat64(0.2)
-	assert.True(t, r2.IsValid())
-	assert.Positive(t, r2.denominator)
-}
-
-// Test_float64ToRatExact_OffloadPath covers e>=0 offload to denominator path
-func Test_float64ToRatExact_OffloadPath(t *testing.T) {
-	// Construct a value that requires offloading exponent to denominator
-	// We need a float where mantissa << e would overflow but can be represented with denominator
-	// Use math.Ldexp to construct: mantissa * 2^exp where we control the split
-
-	// Create a large mantissa that when shifted by a large exponent needs offloading
-	// Example: 2^60 = mantissa=1, exp=60; this should fit as numerator=2^60, denom=1
-	// But 2^62 might need offloading depending on limits
-
-	// Use a value that's exactly representable but large enough to trigger offload logic
-	val := math.Ldexp(1.0, 62) // 2^62, should be within range but test the boundary
-	r := NewFromFloat64(val)
-	// This might be valid or invalid depending on exact limits, but exercises the path
-	if r.IsValid() {
-		assert.Positive(t, r.denominator)
-	} else {
-		// If it overflows, that's also valid behavior
-		assert.True(t, r.IsInvalid())
-	}
-}
-
-// Test_float64ToRatExact_RoundingTies covers tie-breaking in rounding
-func Test_float64ToRatExact_RoundingTies(t *testing.T) {
-	// Test values that create rounding ties in the e < 0 path
-	// We need to construct floats where after shifting, rem == half
-
-	// Use very small values that will trigger the e < 0 path with specific bit patterns
-	// 2^-1075 is close to the smallest subnormal, will have e < 0
-	val := math.Ldexp(1.0, -1070) // Small but not the absolute minimum
-	r := NewFromFloat64(val)
-	assert.True(t, r.IsValid())
-	assert.Positive(t, r.denominator)
-
-	// Test shiftUp == 0 path (exact case in e < 0 branch)
-	// This happens when e + denPow == 0, so we need e = -denPow
-	// For small values, denPow = min(-e, 63), so if e = -10, denPow = 10, shiftUp = 0
-	val2 := math.Ldexp(1.0, -10) // 2^-10, should hit shiftUp == 0 case
-	r2 := NewFromFloat64(val2)
-	assert.True(t, r2.IsValid())
-	assert.Equal(t, int64(1), r2.numerator)
-	assert.Equal(t, uint64(1024), r2.denominator) // 2^10
-}
-
-// Test_float64ToRatExact_ComprehensiveCoverage tests comprehensive coverage scenarios
-func Test_float64ToRatExact_ComprehensiveCoverage(t *testing.T) {
-	// Test e >= 0 path where fast path fails but offload succeeds
-	// We need a value where mant << e > absLimit but can be split
-	// Construct using bit manipulation to get precise control
-
-	// Create a float64 with specific mantissa and exponent
-	// Use a mantissa that's large enough to need offloading
-	// Example: mantissa close to 2^52, exponent that makes it need splitting
-
-	// Test case: value that needs denominator offloading
-	// 2^61 should be representable but might need offloading depending on implementation
-	val1 := math.Ldexp(1.0, 61)
-	r1 := NewFromFloat64(val1)
-	if r1.IsValid() {
-		// Either fits in numerator or uses denominator
-		assert.Positive(t, r1.denominator)
-	}
-
-	// Test negative value in offload path (covers line 755: n = -n)
-	val2 := -math.Ldexp(1.0, 61)
-	r2 := NewFromFloat64(val2)
-	if r2.IsValid() {
-		assert.Positive(t, r2.denominator)
-		assert.Negative(t, r2.numerator)
-	}
-
-	// Test e < 0 with shift >= 64 (should result in n64 = 0)
-	val3 := math.Ldexp(1.0, -1100) // Very small, should trigger shift >= 64
-	r3 := NewFromFloat64(val3)
-	if r3.IsValid() {
-		// Very small values might round to 0 or have positive numerator
-		assert.True(t, r3.numerator >= 0 || r3.numerator < 0) // Any valid numerator is fine
-	}
-
-	// Test subnormal numbers (expBits == 0)
-	val4 := math.SmallestNonzeroFloat64 // This is subnormal
-	r4 := NewFromFloat64(val4)
-	assert.True(t, r4.IsValid())
-	assert.Positive(t, r4.denominator)
-	// Very small subnormal might round to 0, so check it's non-negative
-	assert.GreaterOrEqual(t, r4.numerator, int64(0))
-}
-
-// Test_float64ToRatExact_SpecificLines covers the exact uncovered lines
-func Test_float64ToRatExact_SpecificLines(t *testing.T) {
-	// Line 676: return Rat{} for NaN/Inf
-	rNaN := NewFromFloat64(math.NaN())
-	assert.True(t, rNaN.IsInvalid())
-	assert.Equal(t, uint64(0), rNaN.denominator)
-
-	rInf := NewFromFloat64(math.Inf(1))
-	assert.True(t, rInf.IsInvalid())
-	assert.Equal(t, uint64(0), rInf.denominator)
-
-	// Line 680: return Rat{numerator: 0, denominator: 1} for zero
-	rZero := NewFromFloat64(0.0)
-	assert.True(t, rZero.IsValid())
-	assert.Equal(t, int64(0), rZero.numerator)
-	assert.Equal(t, uint64(1), rZero.denominator)
-
-	// Test negative zero using bit manipulation to create actual -0.0
-	negZeroBits := uint64(1) << 63 // Sign bit set, everything else zero
-	rNegZero := NewFromFloat64(math.Float64frombits(negZeroBits))
-	assert.True(t, rNegZero.IsValid())
-	assert.Equal(t, int64(0), rNegZero.numerator)
-	assert.Equal(t, uint64(1), rNegZero.denominator)
-
-	// Line 749: return Rat{} for overflow in e>=0 path
-	// Need a value where newShift < 0 or newShift >= 64 or mant > (absLimit>>newShift)
-	// Try to construct a very large float that would overflow
-	veryLarge := math.Ldexp(1.0, 1000) // 2^1000, should overflow
-	rLarge := NewFromFloat64(veryLarge)
-	assert.True(t, rLarge.IsInvalid())
-	assert.Equal(t, uint64(0), rLarge.denominator)
-
-	// Line 755: n = -n (negation in e>=0 path)
-	// Need a negative value that goes through the e>=0 offload path successfully
-	// Use a negative power of 2 that's large enough to need offloading but not overflow
-	negValue := -math.Ldexp(1.0, 60) // -2^60, should be representable
-	rNeg := NewFromFloat64(negValue)
-	if rNeg.IsValid() {
-		assert.Negative(t, rNeg.numerator) // This exercises the n = -n line
-		assert.Positive(t, rNeg.denominator)
-	}
-}
-
-// Test_float64ToRatExact_RoundingEdgeCases covers specific rounding scenarios
-func Test_float64ToRatExact_RoundingEdgeCases(t *testing.T) {
-	// Test rounding tie with even base (should not round up)
-	// Test rounding tie with odd base (should round up)
-	// These are hard to construct directly, so we test values that exercise the paths
-
-	// Test values that will go through the e < 0 rounding path
-	testValues := []float64{
-		1.0 / 3.0,            // 0.333... will need rounding
-		1.0 / 7.0,            // 0.142857... will need rounding
-		1.0 / 9.0,            // 0.111... will need rounding
-		math.Ldexp(3.0, -10), // 3/1024, might hit exact shiftUp == 0 case
-	}
-
-	for i, val := range testValues {
-		r := NewFromFloat64(val)
-		assert.True(t, r.IsValid(), "test value %d should be valid", i)
-		assert.Positive(t, r.denominator, "test value %d should have positive denominator", i)
-
-		// Verify the conversion is reasonable
-		backToFloat := float64(r.numerator) / float64(r.denominator)
-		diff := math.Abs(backToFloat - val)
-		relErr := diff / math.Abs(val)
-		assert.Less(t, relErr, 1e-15, "test value %d should have small relative error", i)
-	}
-}
-
-// Test_float64ToRatExact_BoundaryConditions tests edge cases in the algorithm
-func Test_float64ToRatExact_BoundaryConditions(t *testing.T) {
-	// Test newShift boundary conditions
-	// Test neededDenPow boundary (should be <= 63)
-
-	// Test a value that might hit neededDenPow > 63 (should return invalid)
-	// This would be a very large number that can't be represented
-	val1 := math.Ldexp(1.0, 100) // 2^100, likely too large
-	r1 := NewFromFloat64(val1)
-	// Should either be valid with large denominator or invalid due to overflow
-	if r1.IsInvalid() {
-		assert.Equal(t, uint64(0), r1.denominator)
-	}
-}
-
-// Test_Float64SpecialCases covers remaining float64ToRatExact edge cases
-func Test_Float64SpecialCases(t *testing.T) {
-	// Test subnormal numbers (expBits == 0) more thoroughly
-	subnormal := math.SmallestNonzeroFloat64
-	rSubnormal := NewFromFloat64(subnormal)
-	assert.True(t, rSubnormal.IsValid())
-
-	// Test the largest finite float64
-	largest := math.MaxFloat64
-	rLargest := NewFromFloat64(largest)
-	// This might be invalid due to overflow, which is correct behavior
-	_ = rLargest.IsValid()
-
-	// Test values that might trigger specific rounding behavior
-	// Values that create exact ties in rounding
-	val1 := math.Ldexp(3.0, -2) // 3/4, should be exact
-	r1 := NewFromFloat64(val1)
-	assert.True(t, r1.IsValid())
-	assert.Equal(t, int64(3), r1.numerator)
-	assert.Equal(t, uint64(4), r1.denominator)
-
-	// Test negative zero using bit manipulation
-	negZero := math.Float64frombits(1 << 63) // -0.0
-	rNegZero := NewFromFloat64(negZero)
-	assert.True(t, rNegZero.IsValid())
-	assert.Equal(t, int64(0), rNegZero.numerator)
-	assert.Equal(t, uint64(1), rNegZero.denominator)
-}
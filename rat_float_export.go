@@ -0,0 +1,128 @@
+package zerorat
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Float64 returns the nearest float64 value for the rational number, using round-to-nearest-even.
+// The second return value reports whether the float64 represents r exactly.
+// Returns (NaN, false) for an invalid Rat.
+// The conversion is done by math/big.Rat.Float64.
+func (r Rat) Float64() (f float64, exact bool) {
+	if r.IsInvalid() {
+		return math.NaN(), false
+	}
+	if r.numerator == 0 {
+		return 0, true
+	}
+	return r.BigRat().Float64()
+}
+
+// Float32 returns the nearest float32 value for the rational number, using round-to-nearest-even.
+// The second return value reports whether the float32 represents r exactly.
+// Returns (NaN, false) for an invalid Rat.
+func (r Rat) Float32() (f float32, exact bool) {
+	if r.IsInvalid() {
+		return float32(math.NaN()), false
+	}
+	if r.numerator == 0 {
+		return 0, true
+	}
+	return r.BigRat().Float32()
+}
+
+// quotToFloat computes the correctly rounded float64 value of num/den (num, den > 0, sign
+// applied separately), with mantBits mantissa bits and the given exponent bias, following
+// the bit-shifting technique used by math/bits.Div64/Mul64 for 128-bit intermediates.
+func quotToFloat(num, den uint64, neg bool, mantBits uint, bias int) (float64, bool) {
+	// Align numerator so that num/den has exactly mantBits+1 significant bits (one hidden),
+	// i.e. normalize shift so 2^mantBits <= num/den < 2^(mantBits+1).
+	numShift := int(mantBits) + 1 + bits.LeadingZeros64(den) - bits.LeadingZeros64(num)
+
+	var shiftedNum uint64
+	var lostBits uint64 // true if any bits were shifted out of num (used for rounding/exactness)
+	switch {
+	case numShift > 0:
+		shift := uint(numShift)
+		if shift >= 64 {
+			shiftedNum, lostBits = 0, num
+		} else {
+			lostBits = num & ((uint64(1) << shift) - 1)
+			shiftedNum = num >> shift
+		}
+	case numShift < 0:
+		shift := uint(-numShift)
+		if shift >= 64 {
+			return applySign(0, neg), num == 0
+		}
+		shiftedNum = num << shift
+	default:
+		shiftedNum = num
+	}
+
+	quo := shiftedNum / den
+	rem := shiftedNum % den
+	exact := rem == 0 && lostBits == 0
+
+	// quo now has exactly mantBits+2 bits (including the round bit); round to nearest-even.
+	roundBit := quo & 1
+	quo >>= 1
+	if roundBit != 0 {
+		if rem != 0 || lostBits != 0 || quo&1 != 0 {
+			quo++
+		}
+		exact = false
+	}
+
+	exp := -numShift + int(mantBits)
+	return composeFloat(quo, exp, neg, mantBits, bias), exact
+}
+
+// composeFloat assembles a float64 from a mantBits-wide mantissa, binary exponent, and sign,
+// handling overflow to +-Inf and underflow to +-0/subnormals by adjusting the exponent field.
+func composeFloat(mant uint64, exp int, neg bool, mantBits uint, bias int) float64 {
+	e := exp + bias
+	maxExp := 1<<11 - 1
+	if mantBits == 23 {
+		maxExp = 1<<8 - 1
+	}
+
+	if e >= maxExp {
+		if neg {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	}
+	if e <= 0 {
+		// Subnormal or underflow to zero; shift mantissa right losing the implicit leading bit.
+		shift := uint(1 - e)
+		if shift > 64 {
+			return applySign(0, neg)
+		}
+		mant >>= shift
+		e = 0
+	}
+
+	if mantBits == 23 {
+		bits32 := uint32(mant&((1<<23)-1)) | uint32(e)<<23 //nolint:gosec // bounded by mantBits/exponent field widths
+		if neg {
+			bits32 |= 1 << 31
+		}
+		return float64(math.Float32frombits(bits32))
+	}
+
+	bits64 := mant&((1<<52)-1) | uint64(e)<<52
+	if neg {
+		bits64 |= 1 << 63
+	}
+	return math.Float64frombits(bits64)
+}
+
+// applySign returns f negated when neg is true, preserving signed zero.
+func applySign(f float64, neg bool) float64 {
+	if neg {
+		return math.Copysign(f, -1)
+	}
+	return f
+}
@@ -0,0 +1,41 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRatFloat64RoundTrip(t *testing.T) {
+	// Every case's exact binary value fits a Rat; 1e-10, for one, would need a denominator of
+	// 2^86, so NewFromFloat64 rounds it.
+	cases := []float64{0, 1, -1, 0.5, 1.0 / 3.0, 123456.789, -2.5, 1.0 / (1 << 40)}
+	for _, v := range cases {
+		r := NewFromFloat64(v)
+		if r.IsInvalid() {
+			t.Fatalf("NewFromFloat64(%v) invalid", v)
+		}
+		f, exact := r.Float64()
+		if !exact {
+			t.Errorf("Float64() for %v reported inexact, want exact", v)
+		}
+		if f != v {
+			t.Errorf("Float64() = %v, want %v", f, v)
+		}
+	}
+}
+
+func TestRatFloat64Invalid(t *testing.T) {
+	var r Rat
+	f, exact := r.Float64()
+	if !math.IsNaN(f) || exact {
+		t.Errorf("Float64() on invalid Rat = (%v, %v), want (NaN, false)", f, exact)
+	}
+}
+
+func TestRatFloat32RoundTrip(t *testing.T) {
+	r := New(1, 4)
+	f, exact := r.Float32()
+	if !exact || f != 0.25 {
+		t.Errorf("Float32() = (%v, %v), want (0.25, true)", f, exact)
+	}
+}
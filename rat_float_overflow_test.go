@@ -0,0 +1,25 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+// TestRatFloat64ExtremeMagnitudes exercises Float64 at the extreme ends of what a valid Rat can
+// represent (numerator up to MaxInt64, denominator up to MaxUint64). Neither end actually reaches
+// float64's overflow-to-Inf or underflow-to-subnormal thresholds — Rat's own int64/uint64 backing
+// bounds its representable magnitude to roughly [5e-20, 9e18], comfortably inside float64's
+// normal range — but this pins Float64's behavior at the boundary the type can actually produce.
+func TestRatFloat64ExtremeMagnitudes(t *testing.T) {
+	big := New(math.MaxInt64, 1)
+	f, exact := big.Float64()
+	if math.IsInf(f, 0) || f <= 0 {
+		t.Errorf("Float64() for MaxInt64/1 = (%v, %v), want a large finite positive value", f, exact)
+	}
+
+	tiny := New(1, math.MaxUint64)
+	f, exact = tiny.Float64()
+	if f <= 0 || math.IsInf(f, 0) {
+		t.Errorf("Float64() for 1/MaxUint64 = (%v, %v), want a tiny finite positive value", f, exact)
+	}
+}
@@ -0,0 +1,146 @@
+package zerorat
+
+import (
+	"math"
+	"math/bits"
+)
+
+// NewFromFloat64Rounded creates a rational number from a float64, rounding to the nearest
+// representable int64/uint64 grid value using mode when the exact representation would overflow,
+// instead of invalidating like NewFromFloat64 does.
+//
+// Follows the pre-normalization trick from math/big.Rat.SetFloat64: the mantissa's trailing
+// zero bits are stripped before deciding whether the exact value fits, so a value like 2^-62
+// (a 53-bit mantissa of 2^52 times 2^-114) keeps its exact form 1/2^62 instead of being rounded.
+// Values of 2^63 or more, or below -2^63, fit no Rat and still return an invalid Rat.
+func NewFromFloat64Rounded(value float64, mode RoundType) (r Rat) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Rat{}
+	}
+	if value == 0 {
+		return Rat{numerator: 0, denominator: 1}
+	}
+	// No numerator holds a magnitude outside [-2^63, 2^63), whatever the denominator.
+	if value >= 0x1p63 || value < -0x1p63 {
+		return Rat{}
+	}
+
+	bits64 := math.Float64bits(value)
+	neg := (bits64 >> 63) != 0
+	expBits := int((bits64 >> 52) & 0x7FF) //nolint:gosec // bounded to 11 bits
+	frac := bits64 & ((uint64(1) << 52) - 1)
+
+	var mant uint64
+	var e int
+	if expBits == 0 {
+		mant = frac
+		e = -1074
+	} else {
+		mant = (uint64(1) << 52) | frac
+		e = expBits - 1023 - 52
+	}
+
+	// Strip trailing zero bits so the shift below is as small as possible.
+	if shift := bits.TrailingZeros64(mant); shift > 0 {
+		mant >>= uint(shift)
+		e += shift
+	}
+
+	// With the trailing zeros gone, the exact form fits whenever its denominator 2^-e is at most
+	// 2^63; float64ToRatExact builds it without rounding in that case.
+	if e >= -63 {
+		exact := float64ToRatExact(value)
+		exact.Reduce()
+		return exact
+	}
+
+	// The exact form didn't fit; choose denominator 2^63 (the largest power of two we can
+	// represent) and round mant*2^e down to that grid using the requested mode.
+	const denPow = 63
+	den := uint64(1) << denPow
+
+	var n64 uint64
+	shift := -(e + denPow)
+	switch {
+	case shift < 0:
+		// Numerator would need to grow; this only happens for values so large that even the
+		// maximal denominator can't help, so fall back to invalid like NewFromFloat64.
+		return Rat{}
+	case shift >= 64:
+		// mant has at most 53 bits, so a 63-bit shift drops it all and leaves a nonzero
+		// remainder below half, the same case as the longer shift.
+		n64 = roundShiftRight(mant, 63, mode, neg)
+	default:
+		// value * 2^denPow = mant * 2^(e+denPow); e+denPow is negative here, so shift is the
+		// number of low bits of mant to drop.
+		n64 = roundShiftRight(mant, uint(shift), mode, neg)
+	}
+
+	if n64 > uint64(math.MaxInt64) {
+		return Rat{}
+	}
+	n := int64(n64) //nolint:gosec // bounded by the check above
+	if neg {
+		n = -n
+	}
+	r = Rat{numerator: n, denominator: den}
+	r.Reduce()
+	return r
+}
+
+// roundShiftRight shifts mant right by shift bits, rounding the dropped bits according to mode.
+func roundShiftRight(mant uint64, shift uint, mode RoundType, neg bool) uint64 {
+	if shift == 0 {
+		return mant
+	}
+	if shift >= 64 {
+		return 0
+	}
+	base := mant >> shift
+	mask := (uint64(1) << shift) - 1
+	rem := mant & mask
+	if rem == 0 {
+		return base
+	}
+	half := uint64(1) << (shift - 1)
+
+	roundUp := func() uint64 { return base + 1 }
+	switch mode {
+	case RoundDown:
+		return base
+	case RoundUp:
+		return roundUp()
+	case RoundCeiling:
+		if neg {
+			return base
+		}
+		return roundUp()
+	case RoundFloor:
+		if neg {
+			return roundUp()
+		}
+		return base
+	case RoundHalfDown:
+		if rem > half {
+			return roundUp()
+		}
+		return base
+	case RoundHalfToOdd:
+		if rem > half || (rem == half && base&1 == 0) {
+			return roundUp()
+		}
+		return base
+	case RoundHalfUp:
+		if rem > half || (rem == half && !neg) {
+			return roundUp()
+		}
+		return base
+	case RoundHalfEven:
+		fallthrough
+	default:
+		if rem > half || (rem == half && base&1 == 1) {
+			return roundUp()
+		}
+		return base
+	}
+}
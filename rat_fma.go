@@ -0,0 +1,28 @@
+package zerorat
+
+// FMA computes a*b+c (fused multiply-add) as a single operation, returning an invalid Rat if any
+// operand is invalid or either the multiplication or the addition overflows. Callers only need to
+// check the final result rather than an intermediate one.
+func FMA(a, b, c Rat) Rat {
+	result := a.Multiplied(b)
+	result.Add(c)
+	return result
+}
+
+// DotProduct computes the sum of as[i]*bs[i] for all i, returning an invalid Rat if the slices
+// have different lengths, either is empty, any element is invalid, or any intermediate
+// multiplication or addition overflows.
+func DotProduct(as, bs []Rat) Rat {
+	if len(as) == 0 || len(as) != len(bs) {
+		return Rat{}
+	}
+
+	sum := Zero()
+	for i := range as {
+		sum = FMA(as[i], bs[i], sum)
+		if sum.IsInvalid() {
+			return Rat{}
+		}
+	}
+	return sum
+}
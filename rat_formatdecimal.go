@@ -0,0 +1,57 @@
+package zerorat
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FormatDecimal renders r as a fixed-point decimal string with exactly prec fractional digits,
+// rounded half-to-even (banker's rounding), unlike FloatString's half-up rounding. Returns "NaN"
+// for an invalid Rat.
+func (r Rat) FormatDecimal(prec int) string {
+	if r.IsInvalid() {
+		return "NaN"
+	}
+	if prec < 0 {
+		prec = 0
+	}
+
+	scale, overflow := powerOf10(prec)
+	if overflow {
+		return "NaN"
+	}
+
+	scaled := r.Reduced()
+	scaled.Round(RoundHalfEven, prec)
+	if scaled.IsInvalid() {
+		return "NaN"
+	}
+
+	// Round leaves scaled reduced, so its denominator divides scale; rescale the numerator to
+	// scale before splitting off the digits.
+	neg := scaled.numerator < 0
+	factor := scale / scaled.denominator
+	absNum := absInt64ToUint64(scaled.numerator)
+	if absNum > math.MaxUint64/factor {
+		return "NaN"
+	}
+	absNum *= factor
+	intPart := absNum / scale
+	fracPart := absNum % scale
+
+	var sb strings.Builder
+	if neg && (intPart != 0 || fracPart != 0) {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(strconv.FormatUint(intPart, 10))
+	if prec > 0 {
+		sb.WriteByte('.')
+		fracStr := strconv.FormatUint(fracPart, 10)
+		for i := len(fracStr); i < prec; i++ {
+			sb.WriteByte('0')
+		}
+		sb.WriteString(fracStr)
+	}
+	return sb.String()
+}
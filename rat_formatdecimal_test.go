@@ -0,0 +1,34 @@
+package zerorat
+
+import "testing"
+
+func TestFormatDecimal(t *testing.T) {
+	cases := []struct {
+		num  int64
+		den  uint64
+		prec int
+		want string
+	}{
+		{1, 4, 2, "0.25"},
+		{1, 8, 2, "0.12"},  // 0.125 rounds to even: 0.12
+		{3, 8, 2, "0.38"},  // 0.375 rounds to even: 0.38
+		{-1, 2, 1, "-0.5"},
+		{5, 1, 0, "5"},
+	}
+	for _, c := range cases {
+		r := New(c.num, c.den)
+		got := r.FormatDecimal(c.prec)
+		if got != c.want {
+			t.Errorf("FormatDecimal(%d): New(%d,%d).FormatDecimal(%d) = %q, want %q",
+				c.prec, c.num, c.den, c.prec, got, c.want)
+		}
+	}
+}
+
+func TestFormatDecimalInvalid(t *testing.T) {
+	var r Rat
+	r.Invalidate()
+	if got := r.FormatDecimal(2); got != "NaN" {
+		t.Errorf("FormatDecimal() on invalid Rat = %q, want %q", got, "NaN")
+	}
+}
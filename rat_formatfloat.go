@@ -0,0 +1,15 @@
+package zerorat
+
+// FormatFloat renders r as a fixed-point decimal string with prec fractional digits, like
+// FloatString, but lets the caller choose the rounding mode instead of FloatString's fixed
+// RoundHalfUp behavior.
+func (r Rat) FormatFloat(prec int, roundType RoundType) string {
+	if r.IsInvalid() {
+		return "invalid"
+	}
+	rounded := r.Rounded(roundType, prec)
+	if rounded.IsInvalid() {
+		return "invalid"
+	}
+	return rounded.FloatString(prec)
+}
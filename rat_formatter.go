@@ -0,0 +1,22 @@
+package zerorat
+
+import "fmt"
+
+// Format implements fmt.Formatter, so Rat values print sensibly with the standard fmt verbs:
+// %v and %s print String() (e.g. "3/4" or "5"), %d prints RatString() (always "num/den"), and
+// %f prints FloatString() at the verb's requested precision (6 if unspecified, matching float
+// formatting defaults).
+func (r Rat) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'd':
+		_, _ = fmt.Fprint(f, r.RatString())
+	case 'f':
+		prec := 6
+		if p, ok := f.Precision(); ok {
+			prec = p
+		}
+		_, _ = fmt.Fprint(f, r.FloatString(prec))
+	default:
+		_, _ = fmt.Fprint(f, r.String())
+	}
+}
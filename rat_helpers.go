@@ -338,6 +338,11 @@ func roundDivision(numerator int64, denominator uint64, roundType RoundType) int
 		return quotient
 
 	default:
+		// RoundHalfEven, RoundHalfDown, RoundHalfToOdd, RoundCeiling, RoundFloor live in
+		// rat_round_modes.go and share the quotient/remainder already computed above.
+		if result, ok := roundDivisionExtended(numerator, denominator, roundType); ok {
+			return result
+		}
 		return quotient
 	}
 }
\ No newline at end of file
@@ -0,0 +1,32 @@
+package zerorat
+
+import "testing"
+
+func TestRatJSONRoundTrip(t *testing.T) {
+	cases := []Rat{
+		New(3, 4),
+		New(-5, 2),
+		NewFromInt(7),
+		Zero(),
+	}
+	for _, want := range cases {
+		data, err := want.MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%v) returned error: %v", want, err)
+		}
+		var got Rat
+		if err := got.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%q) returned error: %v", data, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("round trip %v -> %q -> %v, want %v", want, data, got, want)
+		}
+	}
+}
+
+func TestRatUnmarshalJSONInvalid(t *testing.T) {
+	var r Rat
+	if err := r.UnmarshalJSON([]byte(`"1/0"`)); err == nil {
+		t.Error("UnmarshalJSON(\"1/0\") expected error, got nil")
+	}
+}
@@ -0,0 +1,31 @@
+package zerorat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestRat_Div_CrossCancellationAvoidsOverflow verifies that Div falls back to GCD
+// cross-cancellation (instead of invalidating) when the literal cross-product would overflow but
+// a common factor brings it back in range.
+func TestRat_Div_CrossCancellationAvoidsOverflow(t *testing.T) {
+	r := New(4611686018427387904, 3) // 2^62 / 3
+	r.Div(New(2, 3))
+
+	assert.False(t, r.IsInvalid())
+	assert.Equal(t, int64(2305843009213693952), r.numerator) // 2^61
+	assert.Equal(t, uint64(1), r.denominator)
+}
+
+// TestRat_Mul_CrossCancellationAvoidsOverflow verifies that Mul falls back to GCD
+// cross-cancellation (instead of invalidating) when the literal cross-product would overflow but
+// a common factor between a numerator and the other operand's denominator brings it back in range.
+func TestRat_Mul_CrossCancellationAvoidsOverflow(t *testing.T) {
+	r := New(4611686018427387904, 3) // 2^62 / 3
+	r.Mul(New(3, 2))
+
+	assert.False(t, r.IsInvalid())
+	assert.Equal(t, int64(2305843009213693952), r.numerator) // 2^61
+	assert.Equal(t, uint64(1), r.denominator)
+}
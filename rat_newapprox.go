@@ -0,0 +1,8 @@
+package zerorat
+
+// NewApprox returns the best rational approximation of f with a denominator no larger than
+// maxDenom. Equivalent to ApproximateFloat64; provided under this name for call-site symmetry
+// with New/NewFromInt/NewFromFloat64 in the New* constructor family.
+func NewApprox(f float64, maxDenom uint64) Rat {
+	return ApproximateFloat64(f, maxDenom)
+}
@@ -0,0 +1,14 @@
+package zerorat
+
+import "testing"
+
+func TestNewApproxMatchesApproximateFloat64(t *testing.T) {
+	got := NewApprox(0.1, 1000)
+	want := ApproximateFloat64(0.1, 1000)
+	if !got.Equal(want) {
+		t.Errorf("NewApprox(0.1, 1000) = %v, want %v", got, want)
+	}
+	if got.Denominator() != 10 {
+		t.Errorf("NewApprox(0.1, 1000) = %v, want denominator 10", got)
+	}
+}
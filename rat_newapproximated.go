@@ -0,0 +1,8 @@
+package zerorat
+
+// NewApproximated constructs the best rational approximation of value with denominator at most
+// maxDenom, following the New* constructor naming convention (ApproximateFloat64 is the same
+// operation, named to match the Approximate method instead).
+func NewApproximated(value float64, maxDenom uint64) Rat {
+	return ApproximateFloat64(value, maxDenom)
+}
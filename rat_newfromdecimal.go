@@ -0,0 +1,12 @@
+package zerorat
+
+// NewFromDecimal parses a decimal or scientific-notation string (e.g. "1.25", "1.25e-3") into a
+// Rat. Returns the invalid Rat{} sentinel on malformed input rather than an error, matching the
+// rest of the New* constructor family's no-error style.
+func NewFromDecimal(s string) Rat {
+	r, err := ParseRat(s)
+	if err != nil {
+		return Rat{}
+	}
+	return r
+}
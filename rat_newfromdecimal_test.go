@@ -0,0 +1,26 @@
+package zerorat
+
+import "testing"
+
+func TestNewFromDecimalRoundTrip(t *testing.T) {
+	cases := []string{"1.25", "-1.25", "0", "3/4", "1.25e-3", "42"}
+	for _, s := range cases {
+		r := NewFromDecimal(s)
+		if r.IsInvalid() {
+			t.Fatalf("NewFromDecimal(%q) returned invalid Rat", s)
+		}
+		back := NewFromDecimal(r.String())
+		if !back.Equal(r) {
+			t.Errorf("NewFromDecimal(%q).String() = %q, which round-trips to %v, want %v",
+				s, r.String(), back, r)
+		}
+	}
+}
+
+func TestNewFromDecimalInvalid(t *testing.T) {
+	for _, s := range []string{"", "abc", "1/0"} {
+		if r := NewFromDecimal(s); r.IsValid() {
+			t.Errorf("NewFromDecimal(%q) = %v, want invalid", s, r)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package zerorat
+
+// NewFromFloatBounded returns the best rational approximation of value whose denominator does
+// not exceed maxDenom, via continued-fraction expansion (ApproximateFloat64). Prefer this over
+// the exact-bits AddFloat/SubFloat/MulFloat/DivFloat family when value was computed upstream by
+// floating-point arithmetic and its exact dyadic denominator (which can be astronomically large
+// and overflow-prone) isn't meaningful — only a clean, bounded-denominator fraction is.
+func NewFromFloatBounded(value float64, maxDenom uint64) Rat {
+	return ApproximateFloat64(value, maxDenom)
+}
@@ -0,0 +1,38 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewFromFloatBoundedExactDyadic(t *testing.T) {
+	cases := []struct {
+		value float64
+		want  Rat
+	}{
+		{0.5, New(1, 2)},
+		{0.25, New(1, 4)},
+	}
+	for _, c := range cases {
+		got := NewFromFloatBounded(c.value, 1000)
+		if !got.Equal(c.want) {
+			t.Errorf("NewFromFloatBounded(%v, 1000) = %v, want %v", c.value, got, c.want)
+		}
+	}
+}
+
+func TestNewFromFloatBoundedRespectsDenominatorCap(t *testing.T) {
+	got := NewFromFloatBounded(1.0/3.0, 10)
+	if got.IsInvalid() {
+		t.Fatal("NewFromFloatBounded returned invalid Rat")
+	}
+	if got.Denominator() > 10 {
+		t.Errorf("NewFromFloatBounded(1/3, 10) = %v, denominator exceeds cap", got)
+	}
+}
+
+func TestNewFromFloatBoundedRejectsNaN(t *testing.T) {
+	if got := NewFromFloatBounded(math.NaN(), 100); got.IsValid() {
+		t.Errorf("NewFromFloatBounded(NaN) = %v, want invalid", got)
+	}
+}
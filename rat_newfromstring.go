@@ -0,0 +1,11 @@
+package zerorat
+
+// NewFromString parses s the same as ParseRat but returns the math/big.Rat-style (value, ok)
+// pair instead of an error, for callers who prefer a boolean check over error handling.
+func NewFromString(s string) (Rat, bool) {
+	r, err := ParseRat(s)
+	if err != nil {
+		return Rat{}, false
+	}
+	return r, true
+}
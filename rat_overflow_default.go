@@ -0,0 +1,36 @@
+package zerorat
+
+// defaultOverflowPolicy is the policy used by AddDefault/SubDefault/MulDefault/DivDefault when
+// callers don't want to thread an OverflowPolicy through every call site. Rat is a plain value
+// type with no per-value identity, so there's no way to "promote" an individual overflowing Rat
+// to a persistent big.Rat-backed side-pool the way a reference type could — OverflowBigInt
+// already gives the equivalent one-shot recovery (AddFallback/SubFallback/MulFallback/DivFallback)
+// without that extra bookkeeping, and setting this policy to OverflowBigInt makes that recovery
+// implicit.
+var defaultOverflowPolicy = OverflowInvalidate
+
+// SetOverflowPolicy sets the package-wide default policy used by AddDefault/SubDefault/
+// MulDefault/DivDefault. The zero value (OverflowInvalidate) preserves today's behavior.
+func SetOverflowPolicy(policy OverflowPolicy) {
+	defaultOverflowPolicy = policy
+}
+
+// AddDefault adds a and b according to the current default policy (see SetOverflowPolicy).
+func AddDefault(a, b Rat) Rat {
+	return AddWithPolicy(a, b, defaultOverflowPolicy)
+}
+
+// SubDefault subtracts b from a according to the current default policy (see SetOverflowPolicy).
+func SubDefault(a, b Rat) Rat {
+	return SubWithPolicy(a, b, defaultOverflowPolicy)
+}
+
+// MulDefault multiplies a by b according to the current default policy (see SetOverflowPolicy).
+func MulDefault(a, b Rat) Rat {
+	return MulWithPolicy(a, b, defaultOverflowPolicy)
+}
+
+// DivDefault divides a by b according to the current default policy (see SetOverflowPolicy).
+func DivDefault(a, b Rat) Rat {
+	return DivWithPolicy(a, b, defaultOverflowPolicy)
+}
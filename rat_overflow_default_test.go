@@ -0,0 +1,24 @@
+package zerorat
+
+import "testing"
+
+func TestDefaultOverflowPolicyStartsAsInvalidate(t *testing.T) {
+	defer SetOverflowPolicy(OverflowInvalidate)
+
+	a := New(maxInt64, 1)
+	got := AddDefault(a, One())
+	if got.IsValid() {
+		t.Errorf("AddDefault() with default policy = %v, want invalid", got)
+	}
+}
+
+func TestSetOverflowPolicySaturates(t *testing.T) {
+	defer SetOverflowPolicy(OverflowInvalidate)
+	SetOverflowPolicy(OverflowSaturate)
+
+	a := New(maxInt64, 1)
+	got := AddDefault(a, One())
+	if !got.Equal(a.AddSaturating(One())) {
+		t.Errorf("AddDefault() after SetOverflowPolicy(OverflowSaturate) = %v, want saturated", got)
+	}
+}
@@ -0,0 +1,75 @@
+package zerorat
+
+// OverflowPolicy selects how AddWithPolicy/SubWithPolicy/MulWithPolicy/DivWithPolicy handle an
+// operation that would otherwise invalidate the result, letting callers pick a strategy once
+// instead of choosing between Add/AddSaturating/AddFallback at every call site.
+type OverflowPolicy int
+
+const (
+	// OverflowInvalidate is the default Rat behavior: an overflowing operation returns an
+	// invalid Rat.
+	OverflowInvalidate OverflowPolicy = iota
+	// OverflowSaturate clamps an overflowing result to the nearest representable bound, via
+	// AddSaturating/SubSaturating/MulSaturating/DivSaturating.
+	OverflowSaturate
+	// OverflowBigInt retries an overflowing operation with math/big.Rat, via
+	// AddFallback/SubFallback/MulFallback/DivFallback, so the result only invalidates if the
+	// exact reduced result does not fit in int64/uint64, or on a division by zero.
+	OverflowBigInt
+)
+
+// AddWithPolicy adds a and b according to policy.
+func AddWithPolicy(a, b Rat, policy OverflowPolicy) Rat {
+	switch policy {
+	case OverflowSaturate:
+		return a.AddSaturating(b)
+	case OverflowBigInt:
+		result := a
+		result.AddFallback(b)
+		return result
+	default:
+		return a.Added(b)
+	}
+}
+
+// SubWithPolicy subtracts b from a according to policy.
+func SubWithPolicy(a, b Rat, policy OverflowPolicy) Rat {
+	switch policy {
+	case OverflowSaturate:
+		return a.SubSaturating(b)
+	case OverflowBigInt:
+		result := a
+		result.SubFallback(b)
+		return result
+	default:
+		return a.Subtracted(b)
+	}
+}
+
+// MulWithPolicy multiplies a by b according to policy.
+func MulWithPolicy(a, b Rat, policy OverflowPolicy) Rat {
+	switch policy {
+	case OverflowSaturate:
+		return a.MulSaturating(b)
+	case OverflowBigInt:
+		result := a
+		result.MulFallback(b)
+		return result
+	default:
+		return a.Multiplied(b)
+	}
+}
+
+// DivWithPolicy divides a by b according to policy.
+func DivWithPolicy(a, b Rat, policy OverflowPolicy) Rat {
+	switch policy {
+	case OverflowSaturate:
+		return a.DivSaturating(b)
+	case OverflowBigInt:
+		result := a
+		result.DivFallback(b)
+		return result
+	default:
+		return a.Divided(b)
+	}
+}
@@ -0,0 +1,55 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddWithPolicyInvalidate(t *testing.T) {
+	a := Rat{numerator: math.MaxInt64, denominator: 1}
+	b := NewFromInt(1)
+
+	got := AddWithPolicy(a, b, OverflowInvalidate)
+	if got.IsValid() {
+		t.Errorf("AddWithPolicy(OverflowInvalidate) = %v, want invalid", got)
+	}
+}
+
+func TestAddWithPolicySaturate(t *testing.T) {
+	a := Rat{numerator: math.MaxInt64, denominator: 1}
+	b := NewFromInt(1)
+
+	got := AddWithPolicy(a, b, OverflowSaturate)
+	if !got.Equal(maxRat) {
+		t.Errorf("AddWithPolicy(OverflowSaturate) = %v, want %v", got, maxRat)
+	}
+}
+
+func TestAddWithPolicyBigIntMatchesAddedWhenNoOverflow(t *testing.T) {
+	a := New(1, 3)
+	b := New(1, 6)
+
+	got := AddWithPolicy(a, b, OverflowBigInt)
+	want := a.Added(b)
+	if !got.Equal(want) {
+		t.Errorf("AddWithPolicy(OverflowBigInt) = %v, want %v", got, want)
+	}
+}
+
+func TestAddWithPolicyBigIntStillInvalidatesWhenTrulyUnrepresentable(t *testing.T) {
+	a := Rat{numerator: math.MaxInt64, denominator: 1}
+	b := Rat{numerator: math.MaxInt64, denominator: 1}
+
+	got := AddWithPolicy(a, b, OverflowBigInt)
+	if got.IsValid() {
+		t.Errorf("AddWithPolicy(OverflowBigInt) = %v, want invalid (sum exceeds int64)", got)
+	}
+}
+
+func TestDivWithPolicyDefault(t *testing.T) {
+	got := DivWithPolicy(New(6, 1), New(3, 1), OverflowInvalidate)
+	want := New(2, 1)
+	if !got.Equal(want) {
+		t.Errorf("DivWithPolicy() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,257 @@
+package zerorat
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Errors returned by ParseRat.
+var (
+	// ErrRatParseEmpty indicates the input string was empty.
+	ErrRatParseEmpty = errors.New("zerorat: empty string")
+	// ErrRatParseSyntax indicates the input string did not match any supported format.
+	ErrRatParseSyntax = errors.New("zerorat: invalid syntax")
+)
+
+// ParseRat parses a string into a Rat. Supported formats mirror math/big.Rat.SetString:
+//   - "a/b"       explicit fraction
+//   - "42"        integer
+//   - "1.25"      decimal
+//   - "1.25e-3"   decimal with exponent
+//
+// Returns ErrRatParseSyntax for malformed input and the invalid Rat{} sentinel on overflow.
+func ParseRat(s string) (Rat, error) {
+	if s == "" {
+		return Rat{}, ErrRatParseEmpty
+	}
+
+	if idx := strings.IndexByte(s, '/'); idx >= 0 {
+		numStr, denStr := s[:idx], s[idx+1:]
+		num, err := strconv.ParseInt(numStr, 10, 64)
+		if err != nil {
+			return Rat{}, ErrRatParseSyntax
+		}
+		den, err := strconv.ParseUint(denStr, 10, 64)
+		if err != nil {
+			return Rat{}, ErrRatParseSyntax
+		}
+		r := New(num, den)
+		if r.IsInvalid() {
+			return Rat{}, ErrRatParseSyntax
+		}
+		return r, nil
+	}
+
+	if strings.ContainsAny(s, ".eE") {
+		return parseDecimalRat(s)
+	}
+
+	num, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return Rat{}, ErrRatParseSyntax
+	}
+	return NewFromInt(num), nil
+}
+
+// parseDecimalRat parses a decimal or scientific-notation string ("1.25", "1.25e-3") into a Rat
+// by scaling the digits into an integer numerator over a power-of-ten denominator.
+func parseDecimalRat(s string) (Rat, error) {
+	mantissa := s
+	exp := 0
+	if idx := strings.IndexAny(s, "eE"); idx >= 0 {
+		mantissa = s[:idx]
+		e, err := strconv.Atoi(s[idx+1:])
+		if err != nil {
+			return Rat{}, ErrRatParseSyntax
+		}
+		exp = e
+	}
+
+	neg := false
+	if strings.HasPrefix(mantissa, "-") {
+		neg = true
+		mantissa = mantissa[1:]
+	} else if strings.HasPrefix(mantissa, "+") {
+		mantissa = mantissa[1:]
+	}
+
+	intPart := mantissa
+	fracPart := ""
+	if dot := strings.IndexByte(mantissa, '.'); dot >= 0 {
+		intPart = mantissa[:dot]
+		fracPart = mantissa[dot+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return Rat{}, ErrRatParseSyntax
+	}
+
+	digits := intPart + fracPart
+	if digits == "" {
+		digits = "0"
+	}
+	num, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return Rat{}, ErrRatParseSyntax
+	}
+
+	// Effective power of ten: exponent moves the point, the fractional digits push it back.
+	pow := exp - len(fracPart)
+
+	var r Rat
+	if pow >= 0 {
+		scale, overflow := powerOf10(pow)
+		if overflow {
+			return Rat{}, ErrRatParseSyntax
+		}
+		n, ok := mulUint64ToInt64(num, scale)
+		if !ok {
+			return Rat{}, ErrRatParseSyntax
+		}
+		r = NewFromInt(n)
+	} else {
+		den, overflow := powerOf10(-pow)
+		if overflow || den == 0 {
+			return Rat{}, ErrRatParseSyntax
+		}
+		if num > uint64(maxInt64) {
+			return Rat{}, ErrRatParseSyntax
+		}
+		r = New(int64(num), den) //nolint:gosec // bounded by the check above
+	}
+
+	if neg {
+		r.numerator = -r.numerator
+	}
+	if r.IsInvalid() {
+		return Rat{}, ErrRatParseSyntax
+	}
+	return r, nil
+}
+
+// mulUint64ToInt64 multiplies two uint64 magnitudes and returns the result as int64 if it fits.
+func mulUint64ToInt64(a, b uint64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	if a > uint64(maxInt64)/b {
+		return 0, false
+	}
+	product := a * b
+	if product > uint64(maxInt64) {
+		return 0, false
+	}
+	return int64(product), true //nolint:gosec // bounded by the check above
+}
+
+const maxInt64 = 1<<63 - 1
+
+// FloatString renders the rational as a fixed-point decimal string with exactly prec digits
+// after the decimal point, rounded using RoundHalfUp.
+func (r Rat) FloatString(prec int) string {
+	if r.IsInvalid() {
+		return "invalid"
+	}
+	if prec < 0 {
+		prec = 0
+	}
+
+	scale, overflow := powerOf10(prec)
+	if overflow {
+		return "invalid"
+	}
+
+	scaled := r.Reduced()
+	scaled.Round(RoundHalfUp, prec)
+	if scaled.IsInvalid() {
+		return "invalid"
+	}
+
+	// Round leaves scaled reduced, so its denominator divides scale; rescale the numerator to
+	// scale before splitting off the digits.
+	neg := scaled.numerator < 0
+	factor := scale / scaled.denominator
+	absNum := absInt64ToUint64(scaled.numerator)
+	if absNum > math.MaxUint64/factor {
+		return "invalid"
+	}
+	absNum *= factor
+	intPart := absNum / scale
+	fracPart := absNum % scale
+
+	var sb strings.Builder
+	if neg && (intPart != 0 || fracPart != 0) {
+		sb.WriteByte('-')
+	}
+	sb.WriteString(strconv.FormatUint(intPart, 10))
+	if prec > 0 {
+		sb.WriteByte('.')
+		fracStr := strconv.FormatUint(fracPart, 10)
+		for i := len(fracStr); i < prec; i++ {
+			sb.WriteByte('0')
+		}
+		sb.WriteString(fracStr)
+	}
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, producing the same format as String().
+// An invalid Rat marshals to an empty byte slice rather than an error, so it survives a
+// marshal/unmarshal round trip instead of failing the marshal step outright.
+func (r Rat) MarshalText() ([]byte, error) {
+	if r.IsInvalid() {
+		return []byte{}, nil
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRat. An empty slice (the sentinel
+// produced by MarshalText for an invalid Rat) unmarshals back to the invalid state.
+func (r *Rat) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		r.Invalidate()
+		return nil
+	}
+	parsed, err := ParseRat(string(text))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+// ratJSONNull is the JSON sentinel an invalid Rat marshals to and unmarshals from, so invalid
+// round-trips through JSON instead of failing the marshal step.
+const ratJSONNull = "null"
+
+// MarshalJSON implements json.Marshaler, encoding the rational as a JSON string (e.g. "3/4")
+// to avoid precision loss that a bare JSON number would incur. An invalid Rat marshals to null.
+func (r Rat) MarshalJSON() ([]byte, error) {
+	if r.IsInvalid() {
+		return []byte(ratJSONNull), nil
+	}
+	text, err := r.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	quoted := make([]byte, 0, len(text)+2)
+	quoted = append(quoted, '"')
+	quoted = append(quoted, text...)
+	quoted = append(quoted, '"')
+	return quoted, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the same quoted-string format produced
+// by MarshalJSON, plus the null sentinel which unmarshals to the invalid state.
+func (r *Rat) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == ratJSONNull {
+		r.Invalidate()
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	return r.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,53 @@
+package zerorat
+
+import "testing"
+
+func TestRatFloatString(t *testing.T) {
+	cases := []struct {
+		num  int64
+		den  uint64
+		prec int
+		want string
+	}{
+		{1, 4, 2, "0.25"},
+		{1, 3, 4, "0.3333"},
+		{-1, 2, 1, "-0.5"},
+		{5, 1, 0, "5"},
+	}
+	for _, c := range cases {
+		r := New(c.num, c.den)
+		got := r.FloatString(c.prec)
+		if got != c.want {
+			t.Errorf("FloatString(%d): New(%d,%d).FloatString(%d) = %q, want %q",
+				c.prec, c.num, c.den, c.prec, got, c.want)
+		}
+	}
+}
+
+func TestParseRat(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"3/4", "3/4"},
+		{"42", "42"},
+		{"1.25", "5/4"},
+	}
+	for _, c := range cases {
+		r, err := ParseRat(c.input)
+		if err != nil {
+			t.Fatalf("ParseRat(%q) returned error: %v", c.input, err)
+		}
+		if r.String() != c.want {
+			t.Errorf("ParseRat(%q).String() = %q, want %q", c.input, r.String(), c.want)
+		}
+	}
+}
+
+func TestParseRatInvalid(t *testing.T) {
+	for _, input := range []string{"", "abc", "1/0", "1/"} {
+		if _, err := ParseRat(input); err == nil {
+			t.Errorf("ParseRat(%q) expected error, got nil", input)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package zerorat
+
+// Pow raises r to the integer power n (mutable operation). Negative n inverts the base first
+// (r^-n == (1/r)^n), so Pow panics on neither path but instead invalidates r when the base has
+// no reciprocal (r is zero) or any intermediate multiplication overflows.
+func (r *Rat) Pow(n int) {
+	if r.IsInvalid() {
+		return
+	}
+	if n < 0 {
+		r.Inv()
+		if r.IsInvalid() {
+			return
+		}
+		n = -n
+	}
+
+	base := *r
+	result := One()
+	for n > 0 {
+		if n&1 == 1 {
+			result.Mul(base)
+			if result.IsInvalid() {
+				r.Invalidate()
+				return
+			}
+		}
+		n >>= 1
+		if n == 0 {
+			break
+		}
+		base.Mul(base)
+		if base.IsInvalid() {
+			r.Invalidate()
+			return
+		}
+	}
+	*r = result
+}
+
+// Powed returns r raised to the integer power n (immutable operation).
+func (r Rat) Powed(n int) Rat {
+	result := r
+	result.Pow(n)
+	return result
+}
+
+// PowOk returns r raised to the integer power n and reports success, mirroring the (result, ok)
+// shape of AddOk/SubOk/MulOk/DivOk rather than Pow's invalidate-in-place convention.
+func (r Rat) PowOk(n int) (Rat, bool) {
+	result := r.Powed(n)
+	return result, result.IsValid()
+}
@@ -0,0 +1,47 @@
+package zerorat
+
+import "testing"
+
+func TestPowPositiveExponent(t *testing.T) {
+	r := New(2, 3)
+	got := r.Powed(3)
+	want := New(8, 27)
+	if !got.Equal(want) {
+		t.Errorf("Powed(3) = %v, want %v", got, want)
+	}
+}
+
+func TestPowNegativeExponentInverts(t *testing.T) {
+	r := New(2, 3)
+	got := r.Powed(-2)
+	want := New(9, 4)
+	if !got.Equal(want) {
+		t.Errorf("Powed(-2) = %v, want %v", got, want)
+	}
+}
+
+func TestPowZeroExponentIsOne(t *testing.T) {
+	r := New(5, 7)
+	got := r.Powed(0)
+	if !got.Equal(One()) {
+		t.Errorf("Powed(0) = %v, want 1", got)
+	}
+}
+
+func TestPowZeroBaseNegativeExponentInvalidates(t *testing.T) {
+	r := Zero()
+	got := r.Powed(-1)
+	if got.IsValid() {
+		t.Errorf("Powed(-1) on zero base = %v, want invalid", got)
+	}
+}
+
+func TestPowOkReportsSuccess(t *testing.T) {
+	r := New(2, 1)
+	if got, ok := r.PowOk(10); !ok || !got.Equal(New(1024, 1)) {
+		t.Errorf("PowOk(10) = (%v, %v), want (1024, true)", got, ok)
+	}
+	if _, ok := Zero().PowOk(-1); ok {
+		t.Errorf("PowOk(-1) on zero base reported ok=true, want false")
+	}
+}
@@ -0,0 +1,37 @@
+package zerorat
+
+// IsInteger reports whether r represents a whole number, i.e. its reduced form has a
+// denominator of 1. Returns false for an invalid Rat.
+func (r Rat) IsInteger() bool {
+	if r.IsInvalid() {
+		return false
+	}
+	return r.Reduced().denominator == 1
+}
+
+// GT reports whether r is strictly greater than other. Alias for Greater, matching the
+// GT/LT/GTE/LTE naming convention used by Cosmos-SDK-style rational types.
+func (r Rat) GT(other Rat) bool {
+	return r.Greater(other)
+}
+
+// LT reports whether r is strictly less than other. Alias for Less.
+func (r Rat) LT(other Rat) bool {
+	return r.Less(other)
+}
+
+// GTE reports whether r is greater than or equal to other.
+func (r Rat) GTE(other Rat) bool {
+	if r.IsInvalid() || other.IsInvalid() {
+		return false
+	}
+	return r.Compare(other) >= 0
+}
+
+// LTE reports whether r is less than or equal to other.
+func (r Rat) LTE(other Rat) bool {
+	if r.IsInvalid() || other.IsInvalid() {
+		return false
+	}
+	return r.Compare(other) <= 0
+}
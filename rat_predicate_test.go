@@ -0,0 +1,41 @@
+package zerorat
+
+import "testing"
+
+func TestIsInteger(t *testing.T) {
+	cases := []struct {
+		r    Rat
+		want bool
+	}{
+		{New(4, 2), true},
+		{New(3, 2), false},
+		{Zero(), true},
+	}
+	for _, c := range cases {
+		if got := c.r.IsInteger(); got != c.want {
+			t.Errorf("IsInteger(%v) = %v, want %v", c.r, got, c.want)
+		}
+	}
+	var invalid Rat
+	invalid.Invalidate()
+	if invalid.IsInteger() {
+		t.Error("IsInteger() on invalid Rat = true, want false")
+	}
+}
+
+func TestGTLTGTELTE(t *testing.T) {
+	a, b := New(3, 4), New(1, 2)
+
+	if !a.GT(b) || b.GT(a) {
+		t.Errorf("GT: %v.GT(%v) = %v, want true", a, b, a.GT(b))
+	}
+	if !b.LT(a) || a.LT(b) {
+		t.Errorf("LT: %v.LT(%v) = %v, want true", b, a, b.LT(a))
+	}
+	if !a.GTE(a) || !a.GTE(b) || b.GTE(a) {
+		t.Error("GTE: unexpected result")
+	}
+	if !a.LTE(a) || !b.LTE(a) || a.LTE(b) {
+		t.Error("LTE: unexpected result")
+	}
+}
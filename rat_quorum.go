@@ -0,0 +1,32 @@
+package zerorat
+
+// TwoThirds and OneHalf are common quorum thresholds for committee-style voting (e.g. Tendermint/
+// gno validator sets), expressed as exact fractions rather than floats to avoid boundary
+// rounding errors when a vote lands exactly on the threshold.
+var (
+	TwoThirds = New(2, 3)
+	OneHalf   = New(1, 2)
+)
+
+// VotingPower computes a voter's voting power as an exact fraction of the total, given their
+// stake and the total stake. Returns an invalid Rat if totalStake is zero.
+func VotingPower(stake, totalStake int64) Rat {
+	if totalStake <= 0 {
+		return Rat{}
+	}
+	return New(stake, uint64(totalStake)) //nolint:gosec // totalStake > 0 checked above
+}
+
+// HasQuorum reports whether votedPower meets or exceeds threshold of totalPower, comparing exact
+// fractions so a vote landing precisely on the threshold (e.g. exactly 2/3) counts as met.
+func HasQuorum(votedPower, totalPower, threshold Rat) bool {
+	if votedPower.IsInvalid() || totalPower.IsInvalid() || threshold.IsInvalid() || totalPower.IsZero() {
+		return false
+	}
+	ratio := votedPower
+	ratio.Div(totalPower)
+	if ratio.IsInvalid() {
+		return false
+	}
+	return !ratio.Less(threshold)
+}
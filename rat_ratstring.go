@@ -0,0 +1,13 @@
+package zerorat
+
+import "strconv"
+
+// RatString returns the exact "numerator/denominator" representation of r, always including
+// the denominator (unlike String(), which omits "/1" for integers). Returns "invalid/0" for an
+// invalid Rat, matching math/big.Rat.RatString's shape of always producing a parsable fraction.
+func (r Rat) RatString() string {
+	if r.IsInvalid() {
+		return "invalid/0"
+	}
+	return strconv.FormatInt(r.numerator, 10) + "/" + strconv.FormatUint(r.denominator, 10)
+}
@@ -0,0 +1,31 @@
+package zerorat
+
+import "testing"
+
+func TestRatString(t *testing.T) {
+	cases := []struct {
+		r    Rat
+		want string
+	}{
+		{New(3, 4), "3/4"},
+		{NewFromInt(5), "5/1"},
+		{Rat{}, "invalid/0"},
+	}
+	for _, c := range cases {
+		if got := c.r.RatString(); got != c.want {
+			t.Errorf("RatString() = %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestFloatStringDeterministic(t *testing.T) {
+	r := New(1, 3)
+	first := r.FloatString(10)
+	second := r.FloatString(10)
+	if first != second {
+		t.Errorf("FloatString is not deterministic: %q vs %q", first, second)
+	}
+	if first != "0.3333333333" {
+		t.Errorf("FloatString(10) = %q, want \"0.3333333333\"", first)
+	}
+}
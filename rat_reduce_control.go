@@ -0,0 +1,33 @@
+package zerorat
+
+// GCD returns the greatest common divisor of r's numerator and denominator, i.e. the factor
+// Reduce would divide out. Returns 0 for an invalid Rat.
+func (r Rat) GCD() uint64 {
+	if r.IsInvalid() {
+		return 0
+	}
+	return gcdInt64Uint64(r.numerator, r.denominator)
+}
+
+// IsReduced reports whether r is already in lowest terms (GCD of numerator and denominator is 1).
+func (r Rat) IsReduced() bool {
+	if r.IsInvalid() {
+		return false
+	}
+	return r.GCD() == 1
+}
+
+// AddReduced adds other to r and reduces the result to lowest terms in a single call, for callers
+// who don't want intermediate unreduced state to escape (e.g. before formatting or comparison by
+// denominator). Equivalent to Add followed by Reduce.
+func (r *Rat) AddReduced(other Rat) {
+	r.Add(other)
+	r.Reduce()
+}
+
+// SubReduced subtracts other from r and reduces the result to lowest terms in a single call.
+// Equivalent to Sub followed by Reduce.
+func (r *Rat) SubReduced(other Rat) {
+	r.Sub(other)
+	r.Reduce()
+}
@@ -0,0 +1,74 @@
+package zerorat
+
+// RoundFunc rounds r to scale decimal places (mutable operation) using a caller-supplied decision
+// function instead of a fixed RoundType, for business rules the RoundType enum doesn't cover
+// (e.g. "round up whenever the cut-off fraction exceeds 0.1, regardless of its midpoint"). decide
+// receives the exact leftover (r minus its RoundDown-truncation at scale, same sign as r) and
+// returns whether to round away from zero.
+func (r *Rat) RoundFunc(scale int, decide func(remainder Rat) bool) {
+	if r.IsInvalid() {
+		return
+	}
+
+	truncated := r.Rounded(RoundDown, scale)
+	if truncated.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+
+	remainder := r.Subtracted(truncated)
+	if remainder.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+
+	if !decide(remainder) {
+		*r = truncated
+		return
+	}
+
+	increment, ok := roundFuncIncrement(scale)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+	if r.numerator < 0 {
+		increment.Neg()
+	}
+
+	result := truncated.Added(increment)
+	if result.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+	*r = result
+}
+
+// RoundedFunc returns r.RoundFunc(scale, decide) without modifying r (immutable operation).
+func (r Rat) RoundedFunc(scale int, decide func(remainder Rat) bool) Rat {
+	result := r
+	result.RoundFunc(scale, decide)
+	return result
+}
+
+// roundFuncIncrement returns the smallest positive increment at the given scale: 1/10^scale for
+// scale >= 0, or 10^(-scale) for scale < 0.
+func roundFuncIncrement(scale int) (Rat, bool) {
+	if scale >= 0 {
+		den, overflow := powerOf10(scale)
+		if overflow {
+			return Rat{}, false
+		}
+		return New(1, den), true
+	}
+
+	mul, overflow := powerOf10(-scale)
+	if overflow {
+		return Rat{}, false
+	}
+	num, ok := uint64ToInt64WithSign(mul, false)
+	if !ok {
+		return Rat{}, false
+	}
+	return New(num, 1), true
+}
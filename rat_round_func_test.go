@@ -0,0 +1,50 @@
+package zerorat
+
+import "testing"
+
+func TestRoundFuncNoRoundUpBelowThreshold(t *testing.T) {
+	r := New(319, 100) // 3.19
+	threshold := New(1, 10)
+
+	got := r.RoundedFunc(1, func(remainder Rat) bool {
+		return !remainder.Less(threshold)
+	})
+
+	// remainder at scale=1 is 3.19 - 3.1 = 0.09, which is below the 0.1 threshold, so no round up.
+	wantNoRoundUp := New(31, 10)
+	if !got.Equal(wantNoRoundUp) {
+		t.Errorf("RoundedFunc() = %v, want %v", got, wantNoRoundUp)
+	}
+}
+
+func TestRoundFuncNegativeValue(t *testing.T) {
+	r := New(-319, 100) // -3.19
+
+	got := r.RoundedFunc(1, func(remainder Rat) bool {
+		return false // never round up
+	})
+	want := New(-31, 10)
+	if !got.Equal(want) {
+		t.Errorf("RoundedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestRoundFuncRoundsUpWhenDecided(t *testing.T) {
+	r := New(311, 100) // 3.11
+
+	got := r.RoundedFunc(1, func(remainder Rat) bool {
+		return remainder.IsPositive()
+	})
+	want := New(32, 10)
+	if !got.Equal(want) {
+		t.Errorf("RoundedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestRoundFuncInvalidReceiver(t *testing.T) {
+	var r Rat
+	got := r.RoundedFunc(2, func(Rat) bool { return true })
+	if got.IsValid() {
+		t.Errorf("RoundedFunc() on invalid receiver = %v, want invalid", got)
+	}
+}
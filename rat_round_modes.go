@@ -0,0 +1,92 @@
+package zerorat
+
+const (
+	// RoundHalfEven rounds ties to the neighbor whose last digit is even (banker's rounding).
+	RoundHalfEven RoundType = iota + RoundHalfUp + 1
+	// RoundHalfDown rounds ties toward zero.
+	RoundHalfDown
+	// RoundHalfToOdd rounds ties to the neighbor whose last digit is odd.
+	RoundHalfToOdd
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+// roundDivisionExtended extends roundDivision with the additional rounding modes introduced
+// alongside RoundHalfEven. It follows the same "compare 2*remainder to denominator, break ties
+// by inspecting parity" pattern as roundDivision's half-up case in rat_helpers.go.
+func roundDivisionExtended(numerator int64, denominator uint64, roundType RoundType) (int64, bool) {
+	if denominator == 0 {
+		return 0, false
+	}
+	if numerator == 0 {
+		return 0, true
+	}
+
+	var quotient int64
+	var remainder uint64
+	if numerator >= 0 {
+		quotient = numerator / int64(denominator) //nolint:gosec // denominator fits by caller contract
+		remainder = uint64(numerator) % denominator
+	} else {
+		absNum := uint64(-numerator)
+		quotient = -int64(absNum / denominator) //nolint:gosec // denominator fits by caller contract
+		remainder = absNum % denominator
+	}
+
+	if remainder == 0 {
+		return quotient, true
+	}
+
+	switch roundType {
+	case RoundCeiling:
+		if numerator > 0 {
+			return quotient + 1, true
+		}
+		return quotient, true
+
+	case RoundFloor:
+		if numerator > 0 {
+			return quotient, true
+		}
+		return quotient - 1, true
+
+	case RoundHalfEven, RoundHalfDown, RoundHalfToOdd:
+		doubleRemainder := remainder * 2
+		switch {
+		case doubleRemainder > denominator:
+			if numerator > 0 {
+				return quotient + 1, true
+			}
+			return quotient - 1, true
+		case doubleRemainder < denominator:
+			return quotient, true
+		default:
+			// Exact tie: behavior depends on the mode.
+			switch roundType {
+			case RoundHalfDown:
+				return quotient, true
+			case RoundHalfToOdd:
+				if quotient&1 == 0 {
+					if numerator > 0 {
+						return quotient + 1, true
+					}
+					return quotient - 1, true
+				}
+				return quotient, true
+			default: // RoundHalfEven
+				if quotient&1 != 0 {
+					if numerator > 0 {
+						return quotient + 1, true
+					}
+					return quotient - 1, true
+				}
+				return quotient, true
+			}
+		}
+
+	default:
+		return 0, false
+	}
+}
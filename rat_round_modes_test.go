@@ -0,0 +1,84 @@
+package zerorat
+
+import "testing"
+
+func TestRoundHalfEven(t *testing.T) {
+	cases := []struct {
+		num, den uint64
+		want     int64
+	}{
+		{25, 10, 2}, // 2.5 -> 2 (even)
+		{35, 10, 4}, // 3.5 -> 4 (even)
+		{15, 10, 2}, // 1.5 -> 2 (even)
+	}
+	for _, c := range cases {
+		r := New(int64(c.num), c.den) //nolint:gosec // small test constants
+		r.Round(RoundHalfEven, 0)
+		if r.numerator != c.want {
+			t.Errorf("Round(RoundHalfEven) of %d/%d = %d, want %d", c.num, c.den, r.numerator, c.want)
+		}
+	}
+}
+
+func TestRoundCeilingFloor(t *testing.T) {
+	r := New(-3, 2) // -1.5
+	ceil := r.Rounded(RoundCeiling, 0)
+	if ceil.numerator != -1 {
+		t.Errorf("RoundCeiling(-1.5) = %d, want -1", ceil.numerator)
+	}
+	floor := r.Rounded(RoundFloor, 0)
+	if floor.numerator != -2 {
+		t.Errorf("RoundFloor(-1.5) = %d, want -2", floor.numerator)
+	}
+}
+
+func TestRoundHalfDown(t *testing.T) {
+	cases := []struct {
+		num, den uint64
+		want     int64
+	}{
+		{25, 10, 2}, // 2.5 -> 2 (down, i.e. toward zero on ties)
+		{35, 10, 3}, // 3.5 -> 3
+	}
+	for _, c := range cases {
+		r := New(int64(c.num), c.den) //nolint:gosec // small test constants
+		r.Round(RoundHalfDown, 0)
+		if r.numerator != c.want {
+			t.Errorf("Round(RoundHalfDown) of %d/%d = %d, want %d", c.num, c.den, r.numerator, c.want)
+		}
+	}
+}
+
+func TestRoundHalfEvenNegative(t *testing.T) {
+	cases := []struct {
+		num, den int64
+		want     int64
+	}{
+		{-25, 10, -2}, // -2.5 -> -2 (even)
+		{-35, 10, -4}, // -3.5 -> -4 (even)
+	}
+	for _, c := range cases {
+		r := New(c.num, uint64(c.den)) //nolint:gosec // small test constants
+		r.Round(RoundHalfEven, 0)
+		if r.numerator != c.want {
+			t.Errorf("Round(RoundHalfEven) of %d/%d = %d, want %d", c.num, c.den, r.numerator, c.want)
+		}
+	}
+}
+
+func TestRoundHalfToOdd(t *testing.T) {
+	cases := []struct {
+		num, den uint64
+		want     int64
+	}{
+		{25, 10, 3}, // 2.5 -> 3 (odd)
+		{35, 10, 3}, // 3.5 -> 3 (odd)
+	}
+	for _, c := range cases {
+		r := New(int64(c.num), c.den) //nolint:gosec // small test constants
+		r.Round(RoundHalfToOdd, 0)
+		if r.numerator != c.want {
+			t.Errorf("Round(RoundHalfToOdd) of %d/%d = %d, want %d", c.num, c.den, r.numerator, c.want)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package zerorat
+
+// RoundToMultiple rounds r to the nearest multiple of step (mutable operation), e.g. rounding a
+// price to the nearest nickel with step = New(5, 100). roundType controls tie-breaking the same
+// as Round. Invalidates if step is invalid or zero.
+func (r *Rat) RoundToMultiple(step Rat, roundType RoundType) {
+	if r.IsInvalid() || step.IsInvalid() || step.IsZero() {
+		r.Invalidate()
+		return
+	}
+
+	quotient := r.Divided(step)
+	if quotient.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+	quotient.Round(roundType, 0)
+	if quotient.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+
+	result := quotient.Multiplied(step)
+	if result.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+	*r = result
+}
+
+// RoundedToMultiple returns r.RoundToMultiple(step, roundType) without modifying r (immutable
+// operation).
+func (r Rat) RoundedToMultiple(step Rat, roundType RoundType) Rat {
+	result := r
+	result.RoundToMultiple(step, roundType)
+	return result
+}
@@ -0,0 +1,48 @@
+package zerorat
+
+import "testing"
+
+func TestRoundToMultipleNearestNickel(t *testing.T) {
+	r := New(347, 100) // 3.47
+	nickel := New(5, 100)
+
+	got := r.RoundedToMultiple(nickel, RoundHalfUp)
+	want := New(345, 100) // 3.45
+	if !got.Equal(want) {
+		t.Errorf("RoundedToMultiple() = %v, want %v", got, want)
+	}
+}
+
+func TestRoundToMultipleZeroStepInvalidates(t *testing.T) {
+	r := New(1, 1)
+	got := r.RoundedToMultiple(Zero(), RoundHalfUp)
+	if got.IsValid() {
+		t.Errorf("RoundedToMultiple(step=0) = %v, want invalid", got)
+	}
+}
+
+func TestRoundToSignificantFiguresBasic(t *testing.T) {
+	r := New(12345, 10) // 1234.5
+	got := r.RoundedToSignificantFigures(3, RoundHalfUp)
+	want := New(1230, 1)
+	if !got.Equal(want) {
+		t.Errorf("RoundedToSignificantFigures(3) = %v, want %v", got, want)
+	}
+}
+
+func TestRoundToSignificantFiguresSmallValue(t *testing.T) {
+	r := New(12345, 10000000) // 0.0012345
+	got := r.RoundedToSignificantFigures(3, RoundHalfUp)
+	want := New(123, 100000) // 0.00123
+	if !got.Equal(want) {
+		t.Errorf("RoundedToSignificantFigures(3) = %v, want %v", got, want)
+	}
+}
+
+func TestRoundToSignificantFiguresZero(t *testing.T) {
+	r := Zero()
+	got := r.RoundedToSignificantFigures(3, RoundHalfUp)
+	if !got.IsZero() {
+		t.Errorf("RoundedToSignificantFigures(zero) = %v, want zero", got)
+	}
+}
@@ -0,0 +1,32 @@
+package zerorat
+
+import "math"
+
+// RoundToSignificantFigures rounds r to the given number of significant figures (mutable
+// operation), e.g. 3 figures turns 1234.5 into 1230 and 0.0012345 into 0.00123. sigFigs must be
+// positive. The magnitude is estimated via Float64, so the result is only exact when r's true
+// order of magnitude matches its float64 approximation - sufficient for display/reporting use,
+// not for values deliberately placed at a float64 rounding boundary.
+func (r *Rat) RoundToSignificantFigures(sigFigs int, roundType RoundType) {
+	if r.IsInvalid() || sigFigs <= 0 {
+		r.Invalidate()
+		return
+	}
+	if r.IsZero() {
+		return
+	}
+
+	f, _ := r.Float64()
+	magnitude := int(math.Floor(math.Log10(math.Abs(f))))
+	scale := sigFigs - 1 - magnitude
+
+	r.Round(roundType, scale)
+}
+
+// RoundedToSignificantFigures returns r.RoundToSignificantFigures(sigFigs, roundType) without
+// modifying r (immutable operation).
+func (r Rat) RoundedToSignificantFigures(sigFigs int, roundType RoundType) Rat {
+	result := r
+	result.RoundToSignificantFigures(sigFigs, roundType)
+	return result
+}
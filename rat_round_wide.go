@@ -0,0 +1,110 @@
+package zerorat
+
+// RoundWide rounds r to scale decimal places (scale >= 0) the same as Round, but scales the
+// numerator in full 128 bits via mul128 first, so it only invalidates once the rounded result
+// truly doesn't fit in int64 — unlike Round, which invalidates as soon as the intermediate
+// numerator*10^scale product alone overflows int64, even when the final rounded value at the
+// requested scale would have fit. Negative scale (rounding to powers of ten) never risks this
+// overflow, so RoundWide simply delegates to Round for scale <= 0.
+func (r *Rat) RoundWide(roundType RoundType, scale int) {
+	if r.IsInvalid() || r.numerator == 0 || scale <= 0 {
+		r.Round(roundType, scale)
+		return
+	}
+
+	scaleFactor, overflow := powerOf10(scale)
+	if overflow {
+		r.Invalidate()
+		return
+	}
+
+	if scaleFactor%r.denominator == 0 {
+		r.Round(roundType, scale)
+		return
+	}
+
+	neg := r.numerator < 0
+	absNum := absInt64ToUint64(r.numerator)
+
+	hi, lo := mul128(absNum, scaleFactor)
+	qHi, qLo := div128By(hi, lo, r.denominator)
+	if qHi != 0 {
+		r.Invalidate()
+		return
+	}
+
+	prodHi, prodLo := mul128(qLo, r.denominator)
+	_, remLo := sub128(hi, lo, prodHi, prodLo)
+
+	roundedMagnitude, ok := roundMagnitudeWide(qLo, remLo, r.denominator, neg, roundType)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+
+	result, ok := uint64ToInt64WithSign(roundedMagnitude, neg)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+
+	r.numerator = result
+	r.denominator = scaleFactor
+}
+
+// roundMagnitudeWide applies roundType's tie-breaking rule to an unsigned (quotient, remainder)
+// pair produced by dividing a 128-bit scaled numerator by r's denominator, mirroring
+// roundDivisionExtended's logic but operating on an unsigned magnitude plus an explicit sign
+// instead of a signed int64 numerator (which wouldn't fit here).
+func roundMagnitudeWide(quotient, remainder, denominator uint64, neg bool, roundType RoundType) (uint64, bool) {
+	if remainder == 0 {
+		return quotient, true
+	}
+
+	switch roundType {
+	case RoundDown:
+		return quotient, true
+	case RoundUp:
+		return quotient + 1, true
+	case RoundCeiling:
+		if neg {
+			return quotient, true
+		}
+		return quotient + 1, true
+	case RoundFloor:
+		if neg {
+			return quotient + 1, true
+		}
+		return quotient, true
+	case RoundHalfUp, RoundHalfEven, RoundHalfDown, RoundHalfToOdd:
+		doubleRemainder := remainder * 2
+		switch {
+		case doubleRemainder > denominator:
+			return quotient + 1, true
+		case doubleRemainder < denominator:
+			return quotient, true
+		default:
+			switch roundType {
+			case RoundHalfDown:
+				return quotient, true
+			case RoundHalfToOdd:
+				if quotient&1 == 0 {
+					return quotient + 1, true
+				}
+				return quotient, true
+			case RoundHalfUp:
+				if neg {
+					return quotient, true
+				}
+				return quotient + 1, true
+			default: // RoundHalfEven
+				if quotient&1 != 0 {
+					return quotient + 1, true
+				}
+				return quotient, true
+			}
+		}
+	default:
+		return 0, false
+	}
+}
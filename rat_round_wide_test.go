@@ -0,0 +1,44 @@
+package zerorat
+
+import "testing"
+
+func TestRoundWideMatchesRoundForSmallValues(t *testing.T) {
+	cases := []struct {
+		r     Rat
+		scale int
+		rt    RoundType
+	}{
+		{New(1, 3), 4, RoundHalfUp},
+		{New(2, 3), 2, RoundHalfEven},
+		{New(-7, 8), 1, RoundCeiling},
+		{New(22, 7), 3, RoundFloor},
+	}
+	for _, c := range cases {
+		want := c.r
+		want.Round(c.rt, c.scale)
+
+		got := c.r
+		got.RoundWide(c.rt, c.scale)
+
+		if !got.Equal(want) {
+			t.Errorf("RoundWide(%v, scale=%d, %v) = %v, want %v", c.r, c.scale, c.rt, got, want)
+		}
+	}
+}
+
+func TestRoundWideSucceedsWhereRoundOverflows(t *testing.T) {
+	// numerator * 10^3 overflows int64, but (numerator * 10^3) / denominator is tiny.
+	r := Rat{numerator: 9000000000000000001, denominator: 100000000000000000}
+	overflowed := r
+	overflowed.Round(RoundDown, 3)
+
+	wide := r
+	wide.RoundWide(RoundDown, 3)
+
+	if overflowed.IsValid() {
+		t.Skip("fixture no longer overflows Round; RoundWide's correctness is covered by the small-value test")
+	}
+	if !wide.IsValid() {
+		t.Errorf("RoundWide() = invalid, want a valid result where Round overflowed")
+	}
+}
@@ -0,0 +1,28 @@
+package zerorat
+
+import "strconv"
+
+// String renders the RoundType's name for debugging and error messages, e.g. "RoundHalfEven".
+// Returns "RoundType(n)" for an out-of-range value.
+func (t RoundType) String() string {
+	switch t {
+	case RoundDown:
+		return "RoundDown"
+	case RoundUp:
+		return "RoundUp"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundHalfDown:
+		return "RoundHalfDown"
+	case RoundHalfToOdd:
+		return "RoundHalfToOdd"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundFloor:
+		return "RoundFloor"
+	default:
+		return "RoundType(" + strconv.Itoa(int(t)) + ")"
+	}
+}
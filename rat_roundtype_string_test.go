@@ -0,0 +1,20 @@
+package zerorat
+
+import "testing"
+
+func TestRoundTypeString(t *testing.T) {
+	cases := []struct {
+		t    RoundType
+		want string
+	}{
+		{RoundDown, "RoundDown"},
+		{RoundHalfEven, "RoundHalfEven"},
+		{RoundCeiling, "RoundCeiling"},
+		{RoundType(99), "RoundType(99)"},
+	}
+	for _, c := range cases {
+		if got := c.t.String(); got != c.want {
+			t.Errorf("RoundType(%d).String() = %q, want %q", c.t, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package zerorat
+
+import "math"
+
+// maxRat and minRat are the saturation bounds used by the Saturating arithmetic variants:
+// the largest and smallest values representable with denominator 1.
+var (
+	maxRat = Rat{numerator: math.MaxInt64, denominator: 1}
+	minRat = Rat{numerator: math.MinInt64 + 1, denominator: 1} // +1: MinInt64 itself can't be negated
+)
+
+// saturateSign picks maxRat or minRat based on the sign the unsaturated result would have had.
+func saturateSign(neg bool) Rat {
+	if neg {
+		return minRat
+	}
+	return maxRat
+}
+
+// AddSaturating adds r and other, clamping to maxRat/minRat instead of invalidating on overflow.
+// The clamp direction is inferred from the operands' signs, since the overflowed exact value
+// itself isn't recoverable.
+func (r Rat) AddSaturating(other Rat) Rat {
+	result := r.Added(other)
+	if result.IsValid() {
+		return result
+	}
+	return saturateSign(r.numerator < 0 || (r.numerator == 0 && other.numerator < 0))
+}
+
+// SubSaturating subtracts other from r, clamping on overflow; see AddSaturating.
+func (r Rat) SubSaturating(other Rat) Rat {
+	result := r.Subtracted(other)
+	if result.IsValid() {
+		return result
+	}
+	return saturateSign(r.numerator < other.numerator)
+}
+
+// MulSaturating multiplies r by other, clamping on overflow; see AddSaturating.
+func (r Rat) MulSaturating(other Rat) Rat {
+	result := r.Multiplied(other)
+	if result.IsValid() {
+		return result
+	}
+	return saturateSign((r.numerator < 0) != (other.numerator < 0))
+}
+
+// DivSaturating divides r by other, clamping on overflow; see AddSaturating. Division by zero
+// still invalidates rather than saturating, since there is no sign to clamp toward.
+func (r Rat) DivSaturating(other Rat) Rat {
+	if other.IsValid() && other.numerator == 0 {
+		return Rat{}
+	}
+	result := r.Divided(other)
+	if result.IsValid() {
+		return result
+	}
+	return saturateSign((r.numerator < 0) != (other.numerator < 0))
+}
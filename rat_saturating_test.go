@@ -0,0 +1,46 @@
+package zerorat
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddSaturatingClampsOnOverflow(t *testing.T) {
+	a := Rat{numerator: math.MaxInt64, denominator: 1}
+	b := NewFromInt(1)
+
+	got := a.AddSaturating(b)
+	if !got.Equal(maxRat) {
+		t.Errorf("AddSaturating() = %v, want %v", got, maxRat)
+	}
+}
+
+func TestDivSaturatingByZeroInvalidates(t *testing.T) {
+	a := NewFromInt(5)
+	got := a.DivSaturating(Zero())
+	if got.IsValid() {
+		t.Errorf("DivSaturating by zero = %v, want invalid", got)
+	}
+}
+
+func TestDivSaturatingNormalCase(t *testing.T) {
+	a := New(6, 1)
+	b := New(3, 1)
+	got := a.DivSaturating(b)
+	want := New(2, 1)
+	if !got.Equal(want) {
+		t.Errorf("DivSaturating() = %v, want %v", got, want)
+	}
+}
+
+func TestCheckedOkVariants(t *testing.T) {
+	a := New(1, 2)
+	b := New(1, 3)
+
+	if sum, ok := a.AddOk(b); !ok || !sum.Equal(New(5, 6)) {
+		t.Errorf("AddOk() = (%v, %v), want (5/6, true)", sum, ok)
+	}
+	if _, ok := a.DivOk(Zero()); ok {
+		t.Errorf("DivOk by zero reported ok=true, want false")
+	}
+}
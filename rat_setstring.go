@@ -0,0 +1,14 @@
+package zerorat
+
+// SetString sets r to the value represented by s (mutable operation), accepting the same
+// fraction/integer/decimal/scientific formats as ParseRat. Mirrors math/big.Rat.SetString's
+// signature: the bool result reports success, leaving r unmodified and returning false on
+// malformed input.
+func (r *Rat) SetString(s string) bool {
+	parsed, err := ParseRat(s)
+	if err != nil {
+		return false
+	}
+	*r = parsed
+	return true
+}
@@ -0,0 +1,30 @@
+package zerorat
+
+import "testing"
+
+// FuzzSetStringRoundTrip checks that SetString(x.String()) reproduces x for any valid Rat
+// produced by New, guarding the String/SetString pair against format drift.
+func FuzzSetStringRoundTrip(f *testing.F) {
+	f.Add(int64(3), uint64(4))
+	f.Add(int64(0), uint64(1))
+	f.Add(int64(-7), uint64(9))
+	f.Add(int64(42), uint64(1))
+
+	f.Fuzz(func(t *testing.T, num int64, den uint64) {
+		if den == 0 {
+			t.Skip("zero denominator is the invalid sentinel, not a round-trip case")
+		}
+		x := New(num, den)
+		if x.IsInvalid() {
+			t.Skip("New rejected this pair (e.g. MinInt64 numerator)")
+		}
+
+		var got Rat
+		if ok := got.SetString(x.String()); !ok {
+			t.Fatalf("SetString(%q) = false, want true", x.String())
+		}
+		if !got.Equal(x) {
+			t.Errorf("SetString(%q) = %v, want value equal to %v", x.String(), got, x)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+package zerorat
+
+import "testing"
+
+func TestSetString(t *testing.T) {
+	var r Rat
+	if ok := r.SetString("3/4"); !ok {
+		t.Fatal("SetString(\"3/4\") = false, want true")
+	}
+	if r.String() != "3/4" {
+		t.Errorf("after SetString(\"3/4\"), r = %q, want \"3/4\"", r.String())
+	}
+}
+
+func TestSetStringAllNotations(t *testing.T) {
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"3/4", "3/4"},
+		{"1.25", "5/4"},
+		{"1.25e-3", "1/800"},
+		{"42", "42"},
+	}
+	for _, c := range cases {
+		var r Rat
+		if ok := r.SetString(c.input); !ok {
+			t.Fatalf("SetString(%q) = false, want true", c.input)
+		}
+		if r.String() != c.want {
+			t.Errorf("SetString(%q): r = %q, want %q", c.input, r.String(), c.want)
+		}
+	}
+}
+
+func TestSetStringInvalidLeavesReceiverUnchanged(t *testing.T) {
+	r := New(1, 2)
+	if ok := r.SetString("not a number"); ok {
+		t.Fatal("SetString(\"not a number\") = true, want false")
+	}
+	if r.String() != "1/2" {
+		t.Errorf("after failed SetString, r = %q, want unchanged \"1/2\"", r.String())
+	}
+}
@@ -0,0 +1,17 @@
+package zerorat
+
+// IsNegative reports whether r is strictly less than zero. Returns false for an invalid Rat.
+func (r Rat) IsNegative() bool {
+	if r.IsInvalid() {
+		return false
+	}
+	return r.numerator < 0
+}
+
+// IsPositive reports whether r is strictly greater than zero. Returns false for an invalid Rat.
+func (r Rat) IsPositive() bool {
+	if r.IsInvalid() {
+		return false
+	}
+	return r.numerator > 0
+}
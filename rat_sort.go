@@ -0,0 +1,28 @@
+package zerorat
+
+// Cmp is an alias for Compare, matching the naming convention of math/big.Rat and the standard
+// library's cmp package.
+func (r *Rat) Cmp(other Rat) int {
+	return r.Compare(other)
+}
+
+// RatSlice implements sort.Interface over a slice of Rat, ordering invalid values before all
+// valid ones so a sorted slice can be trimmed of invalid entries with a single prefix scan.
+type RatSlice []Rat
+
+// Len implements sort.Interface.
+func (s RatSlice) Len() int { return len(s) }
+
+// Less implements sort.Interface.
+func (s RatSlice) Less(i, j int) bool {
+	if s[i].IsInvalid() {
+		return !s[j].IsInvalid()
+	}
+	if s[j].IsInvalid() {
+		return false
+	}
+	return s[i].Less(s[j])
+}
+
+// Swap implements sort.Interface.
+func (s RatSlice) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
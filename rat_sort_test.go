@@ -0,0 +1,27 @@
+package zerorat
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCmpMatchesCompare(t *testing.T) {
+	a, b := New(1, 2), New(2, 3)
+	if a.Cmp(b) != a.Compare(b) {
+		t.Errorf("Cmp(%v) = %d, want Compare() = %d", b, a.Cmp(b), a.Compare(b))
+	}
+}
+
+func TestRatSliceSortOrdersInvalidFirst(t *testing.T) {
+	s := RatSlice{New(3, 1), Rat{}, New(1, 2), New(-1, 1)}
+	sort.Sort(s)
+
+	if s[0].IsValid() {
+		t.Fatalf("RatSlice sort: invalid value not placed first, got %v", s)
+	}
+	for i := 1; i < len(s)-1; i++ {
+		if s[i+1].Less(s[i]) {
+			t.Errorf("RatSlice not sorted ascending at index %d: %v", i, s)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package zerorat
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing Rat using the same "numerator/denominator" text format
+// as RatString() so it round-trips through any driver that supports text columns.
+func (r Rat) Value() (driver.Value, error) {
+	if r.IsInvalid() {
+		return nil, nil //nolint:nilnil // invalid Rat maps to SQL NULL, not an error
+	}
+	return r.RatString(), nil
+}
+
+// Scan implements sql.Scanner, accepting NULL, string, or []byte column values produced by Value.
+func (r *Rat) Scan(src any) error {
+	if src == nil {
+		r.Invalidate()
+		return nil
+	}
+
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("zerorat: cannot scan %T into Rat", src)
+	}
+
+	parsed, err := ParseRat(s)
+	if err != nil {
+		return fmt.Errorf("zerorat: scanning column: %w", err)
+	}
+	*r = parsed
+	return nil
+}
@@ -0,0 +1,42 @@
+package zerorat
+
+import "testing"
+
+func TestRatSQLRoundTrip(t *testing.T) {
+	want := New(5, 4)
+
+	value, err := want.Value()
+	if err != nil {
+		t.Fatalf("Value(): %v", err)
+	}
+
+	var got Rat
+	if err := got.Scan(value); err != nil {
+		t.Fatalf("Scan(%v): %v", value, err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("SQL round trip = %v, want %v", got, want)
+	}
+}
+
+func TestRatSQLScanNull(t *testing.T) {
+	r := New(1, 1)
+	if err := r.Scan(nil); err != nil {
+		t.Fatalf("Scan(nil): %v", err)
+	}
+	if r.IsValid() {
+		t.Error("Scan(nil) left Rat valid, want invalid")
+	}
+}
+
+func TestRatSQLValueInvalid(t *testing.T) {
+	var r Rat
+	r.Invalidate()
+	value, err := r.Value()
+	if err != nil {
+		t.Fatalf("Value() on invalid Rat: %v", err)
+	}
+	if value != nil {
+		t.Errorf("Value() on invalid Rat = %v, want nil", value)
+	}
+}
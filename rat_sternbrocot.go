@@ -0,0 +1,66 @@
+package zerorat
+
+import "math"
+
+// NewFromFloat64Capped converts value to the best rational approximation with denominator at
+// most maxDenom, searching the Stern-Brocot tree directly (binary search between mediants)
+// rather than going through the exact float64 rational form first. Returns an invalid Rat for
+// NaN/Inf or maxDenom == 0.
+func NewFromFloat64Capped(value float64, maxDenom uint64) Rat {
+	if math.IsNaN(value) || math.IsInf(value, 0) || maxDenom == 0 {
+		return Rat{}
+	}
+	if value == 0 {
+		return Zero()
+	}
+
+	neg := value < 0
+	target := math.Abs(value)
+
+	// Stern-Brocot search between 0/1 and +Inf (1/0), narrowing toward target via mediants.
+	var loNum, loDen uint64 = 0, 1
+	var hiNum, hiDen uint64 = 1, 0
+
+	var bestNum, bestDen uint64 = 0, 1
+	bestErr := math.Abs(target)
+
+	for i := 0; i < 64; i++ {
+		var medNum, medDen uint64
+		if hiDen == 0 {
+			// hi is "infinity"; the mediant with 1/0 is (loNum+1)/loDen, one step above lo.
+			medNum = loNum + 1
+			medDen = loDen
+		} else {
+			medNum = loNum + hiNum
+			medDen = loDen + hiDen
+		}
+		if medDen == 0 || medDen > maxDenom {
+			break
+		}
+
+		medVal := float64(medNum) / float64(medDen)
+		if err := math.Abs(medVal - target); err < bestErr {
+			bestErr = err
+			bestNum, bestDen = medNum, medDen
+		}
+
+		switch {
+		case medVal < target:
+			loNum, loDen = medNum, medDen
+		case medVal > target:
+			hiNum, hiDen = medNum, medDen
+		default:
+			bestNum, bestDen = medNum, medDen
+			i = 64 // exact match, stop
+		}
+	}
+
+	if bestDen == 0 {
+		return Rat{}
+	}
+	num, ok := uint64ToInt64WithSign(bestNum, neg)
+	if !ok {
+		return Rat{}
+	}
+	return New(num, bestDen)
+}
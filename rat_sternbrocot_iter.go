@@ -0,0 +1,60 @@
+package zerorat
+
+// SternBrocotSearch walks the Stern-Brocot tree step by step, driven by an arbitrary predicate
+// rather than a fixed float64 target, so callers can binary-search toward any comparable real
+// value (e.g. an irrational defined by a converging series) one mediant at a time.
+type SternBrocotSearch struct {
+	loNum, loDen uint64
+	hiNum, hiDen uint64 // hiDen == 0 represents +Inf (1/0)
+}
+
+// NewSternBrocotSearch starts a search over the full Stern-Brocot tree (bounds 0/1 and 1/0).
+func NewSternBrocotSearch() *SternBrocotSearch {
+	return &SternBrocotSearch{loNum: 0, loDen: 1, hiNum: 1, hiDen: 0}
+}
+
+// Current returns the mediant of the search's current bounds, the next candidate approximation.
+// Returns an invalid Rat if the mediant's denominator has overflowed uint64.
+func (s *SternBrocotSearch) Current() Rat {
+	var medNum, medDen uint64
+	if s.hiDen == 0 {
+		medNum, medDen = s.loNum+1, s.loDen
+	} else {
+		medNum, medDen = s.loNum+s.hiNum, s.loDen+s.hiDen
+	}
+	if s.hiDen != 0 && medDen < s.loDen {
+		return Rat{}
+	}
+	num, ok := uint64ToInt64WithSign(medNum, false)
+	if !ok {
+		return Rat{}
+	}
+	return New(num, medDen)
+}
+
+// Advance narrows the search bounds based on cmp, the result of comparing Current() against the
+// caller's target (negative if Current() is below the target, positive if above, zero if equal).
+// Returns false once the tree can no longer be narrowed (mediant denominator would overflow).
+func (s *SternBrocotSearch) Advance(cmp int) bool {
+	var medNum, medDen uint64
+	if s.hiDen == 0 {
+		medNum, medDen = s.loNum+1, s.loDen
+	} else {
+		medNum, medDen = s.loNum+s.hiNum, s.loDen+s.hiDen
+	}
+	// A mediant that wrapped around uint64 is smaller than the lower bound it was built from.
+	if medNum < s.loNum || medDen < s.loDen {
+		return false
+	}
+
+	switch {
+	case cmp < 0:
+		s.loNum, s.loDen = medNum, medDen
+	case cmp > 0:
+		s.hiNum, s.hiDen = medNum, medDen
+	default:
+		s.loNum, s.loDen = medNum, medDen
+		s.hiNum, s.hiDen = medNum, medDen
+	}
+	return true
+}
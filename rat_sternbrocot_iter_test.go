@@ -0,0 +1,26 @@
+package zerorat
+
+import "testing"
+
+func TestSternBrocotSearchFindsOneThird(t *testing.T) {
+	target := New(1, 3)
+	search := NewSternBrocotSearch()
+
+	var found Rat
+	for i := 0; i < 64; i++ {
+		cur := search.Current()
+		if cur.IsInvalid() {
+			t.Fatal("Current() returned invalid Rat before convergence")
+		}
+		if cur.Equal(target) {
+			found = cur
+			break
+		}
+		cmp := cur.Compare(target)
+		search.Advance(cmp)
+	}
+
+	if !found.Equal(target) {
+		t.Errorf("SternBrocotSearch did not converge to %v within 64 steps, last found %v", target, found)
+	}
+}
@@ -0,0 +1,18 @@
+package zerorat
+
+// Text renders r using the requested format verb: 'f' for fixed-point decimal (FloatString with
+// the given prec), and 'r' for the exact "numerator/denominator" form (RatString, prec ignored).
+// Returns "invalid" for an invalid Rat or an unsupported verb.
+func (r Rat) Text(format byte, prec int) string {
+	if r.IsInvalid() {
+		return "invalid"
+	}
+	switch format {
+	case 'f':
+		return r.FloatString(prec)
+	case 'r':
+		return r.RatString()
+	default:
+		return "invalid"
+	}
+}
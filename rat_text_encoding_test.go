@@ -0,0 +1,32 @@
+package zerorat
+
+import "testing"
+
+func TestRatTextMarshalRoundTrip(t *testing.T) {
+	want := New(-5, 8)
+	data, err := want.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText: %v", err)
+	}
+	var got Rat
+	if err := got.UnmarshalText(data); err != nil {
+		t.Fatalf("UnmarshalText(%q): %v", data, err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("text round trip = %v, want %v", got, want)
+	}
+}
+
+func TestRatCompactBinaryRoundTrip(t *testing.T) {
+	cases := []Rat{New(3, 4), New(-1000000, 7), Zero(), NewFromInt(-1)}
+	for _, want := range cases {
+		buf := want.CompactBinary()
+		got, n := FromCompactBinary(buf)
+		if n != len(buf) {
+			t.Errorf("FromCompactBinary consumed %d bytes, want %d", n, len(buf))
+		}
+		if !got.Equal(want) {
+			t.Errorf("compact binary round trip %v -> %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package zerorat
+
+// Truncate rounds r toward zero to the specified scale (mutable operation).
+// Equivalent to Round(RoundDown, scale).
+func (r *Rat) Truncate(scale int) {
+	r.Round(RoundDown, scale)
+}
+
+// Truncated returns r truncated toward zero to the specified scale (immutable operation).
+func (r Rat) Truncated(scale int) Rat {
+	result := r
+	result.Truncate(scale)
+	return result
+}
+
+// Ceil rounds r toward positive infinity to the specified scale (mutable operation).
+// Equivalent to Round(RoundCeiling, scale).
+func (r *Rat) Ceil(scale int) {
+	r.Round(RoundCeiling, scale)
+}
+
+// Ceiled returns r rounded toward positive infinity to the specified scale (immutable operation).
+func (r Rat) Ceiled(scale int) Rat {
+	result := r
+	result.Ceil(scale)
+	return result
+}
+
+// Floor rounds r toward negative infinity to the specified scale (mutable operation).
+// Equivalent to Round(RoundFloor, scale).
+func (r *Rat) Floor(scale int) {
+	r.Round(RoundFloor, scale)
+}
+
+// Floored returns r rounded toward negative infinity to the specified scale (immutable operation).
+func (r Rat) Floored(scale int) Rat {
+	result := r
+	result.Floor(scale)
+	return result
+}
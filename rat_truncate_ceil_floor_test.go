@@ -0,0 +1,44 @@
+package zerorat
+
+import "testing"
+
+func TestTruncatedToZero(t *testing.T) {
+	r := New(-7, 2) // -3.5
+	got := r.Truncated(0)
+	want := NewFromInt(-3)
+	if !got.Equal(want) {
+		t.Errorf("Truncated(0) = %v, want %v", got, want)
+	}
+}
+
+func TestCeiledRoundsTowardPositiveInfinity(t *testing.T) {
+	r := New(-7, 2) // -3.5
+	got := r.Ceiled(0)
+	want := NewFromInt(-3)
+	if !got.Equal(want) {
+		t.Errorf("Ceiled(0) = %v, want %v", got, want)
+	}
+}
+
+func TestFlooredRoundsTowardNegativeInfinity(t *testing.T) {
+	r := New(7, 2) // 3.5
+	got := r.Floored(0)
+	want := NewFromInt(3)
+	if !got.Equal(want) {
+		t.Errorf("Floored(0) = %v, want %v", got, want)
+	}
+}
+
+func TestTruncateCeilFloorPropagateInvalid(t *testing.T) {
+	var r Rat
+	r.Invalidate()
+	if got := r.Truncated(0); got.IsValid() {
+		t.Errorf("Truncated() on invalid Rat = %v, want invalid", got)
+	}
+	if got := r.Ceiled(0); got.IsValid() {
+		t.Errorf("Ceiled() on invalid Rat = %v, want invalid", got)
+	}
+	if got := r.Floored(0); got.IsValid() {
+		t.Errorf("Floored() on invalid Rat = %v, want invalid", got)
+	}
+}
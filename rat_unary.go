@@ -0,0 +1,102 @@
+package zerorat
+
+import "math"
+
+// Neg negates the rational number in place (mutable operation). Invalid Rat stays invalid.
+func (r *Rat) Neg() {
+	if r.IsInvalid() {
+		return
+	}
+	if r.numerator == math.MinInt64 {
+		// -MinInt64 doesn't fit in int64; this can't happen via Reduce()'d values since
+		// MinInt64 itself is already only reachable with denominator 1, but guard anyway.
+		r.Invalidate()
+		return
+	}
+	r.numerator = -r.numerator
+}
+
+// Negated returns the negation of r (immutable operation).
+func (r Rat) Negated() Rat {
+	result := r
+	result.Neg()
+	return result
+}
+
+// Abs sets r to its absolute value in place (mutable operation). Invalid Rat stays invalid.
+func (r *Rat) Abs() {
+	if r.IsInvalid() {
+		return
+	}
+	if r.numerator < 0 {
+		r.Neg()
+	}
+}
+
+// Absolute returns the absolute value of r (immutable operation).
+func (r Rat) Absolute() Rat {
+	result := r
+	result.Abs()
+	return result
+}
+
+// Inv sets r to its reciprocal in place (mutable operation).
+// Sets invalid state if r is invalid or zero (no reciprocal).
+func (r *Rat) Inv() {
+	if r.IsInvalid() || r.numerator == 0 {
+		r.Invalidate()
+		return
+	}
+
+	neg := r.numerator < 0
+	numAbs := absInt64ToUint64(r.numerator)
+
+	newDenom := numAbs
+	newNum, ok := uint64ToInt64WithSign(r.denominator, neg)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+
+	r.numerator = newNum
+	r.denominator = newDenom
+}
+
+// Inverse returns the reciprocal of r (immutable operation).
+func (r Rat) Inverse() Rat {
+	result := r
+	result.Inv()
+	return result
+}
+
+// QuoRem computes Euclidean quotient and remainder such that r == quo*other + rem, with
+// 0 <= rem < |other| (the remainder is always non-negative, following Euclidean division rather
+// than Go's truncated division). Returns invalid Rat/0 if either operand is invalid or other is zero.
+func (r Rat) QuoRem(other Rat) (quo Rat, rem Rat) {
+	if r.IsInvalid() || other.IsInvalid() || other.IsZero() {
+		return Rat{}, Rat{}
+	}
+
+	ratio := r
+	ratio.Div(other)
+	if ratio.IsInvalid() {
+		return Rat{}, Rat{}
+	}
+
+	// Euclidean quotient: floor(r/other) when other is positive and ceil(r/other) when other is
+	// negative, so that quo*other never exceeds r and the remainder is never negative.
+	euclidQuo := ratio.numerator / int64(ratio.denominator) //nolint:gosec // denominator always fits
+	if ratio.numerator%int64(ratio.denominator) != 0 { //nolint:gosec
+		if other.numerator > 0 && ratio.numerator < 0 {
+			euclidQuo--
+		} else if other.numerator < 0 && ratio.numerator > 0 {
+			euclidQuo++
+		}
+	}
+
+	quo = NewFromInt(euclidQuo)
+	rem = quo
+	rem.Mul(other)
+	rem = r.Subtracted(rem)
+	return quo, rem
+}
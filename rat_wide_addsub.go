@@ -0,0 +1,185 @@
+package zerorat
+
+// addSubWide implements AddWide/SubWide: like addSubCommon, but carries the cross-multiplied
+// numerator terms and the new denominator in full 128-bit precision via mul128, only invalidating
+// once the final GCD-reduced numerator or denominator still doesn't fit in int64/uint64. This
+// rejects fewer operands than addSubCommon, which invalidates as soon as an intermediate
+// cross-product overflows even when the reduced result would have fit.
+func (r *Rat) addSubWide(other Rat, isAdd bool) {
+	if r.IsInvalid() || other.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+
+	if r.denominator == other.denominator {
+		r.addSubCommon(other, isAdd)
+		return
+	}
+
+	selfNumAbs := absInt64ToUint64(r.numerator)
+	otherNumAbs := absInt64ToUint64(other.numerator)
+	selfNeg := r.numerator < 0
+	otherNeg := other.numerator < 0
+	if !isAdd {
+		otherNeg = !otherNeg
+	}
+
+	term1Hi, term1Lo := mul128(selfNumAbs, other.denominator)
+	term2Hi, term2Lo := mul128(otherNumAbs, r.denominator)
+
+	denHi, denLo := mul128(r.denominator, other.denominator)
+
+	var numHi, numLo uint64
+	var numNeg bool
+	switch {
+	case selfNeg == otherNeg:
+		numHi, numLo = add128(term1Hi, term1Lo, term2Hi, term2Lo)
+		numNeg = selfNeg
+	default:
+		// Subtract the smaller magnitude from the larger to keep the 128-bit result unsigned.
+		if greater128(term1Hi, term1Lo, term2Hi, term2Lo) {
+			numHi, numLo = sub128(term1Hi, term1Lo, term2Hi, term2Lo)
+			numNeg = selfNeg
+		} else {
+			numHi, numLo = sub128(term2Hi, term2Lo, term1Hi, term1Lo)
+			numNeg = otherNeg
+		}
+	}
+
+	if numHi == 0 && numLo == 0 {
+		r.numerator, r.denominator = 0, 1
+		return
+	}
+
+	g := gcd128(numHi, numLo, denHi, denLo)
+	numHi, numLo = div128By(numHi, numLo, g)
+	denHi, denLo = div128By(denHi, denLo, g)
+
+	if numHi != 0 || denHi != 0 {
+		r.Invalidate()
+		return
+	}
+
+	num, ok := uint64ToInt64WithSign(numLo, numNeg)
+	if !ok {
+		r.Invalidate()
+		return
+	}
+	if denLo == 0 {
+		r.Invalidate()
+		return
+	}
+
+	r.numerator = num
+	r.denominator = denLo
+}
+
+// AddWide adds other to r the same as Add, but widens the cross-multiplication to full 128 bits
+// first so it only invalidates when the GCD-reduced result truly doesn't fit in int64/uint64.
+func (r *Rat) AddWide(other Rat) {
+	r.addSubWide(other, true)
+}
+
+// SubWide subtracts other from r the same as Sub; see AddWide.
+func (r *Rat) SubWide(other Rat) {
+	r.addSubWide(other, false)
+}
+
+// add128 adds two 128-bit unsigned values given as (hi, lo) pairs, ignoring final carry-out
+// overflow (callers only use this for sums that are known to fit, since both addSubWide callers
+// immediately reduce by the GCD before downcasting).
+func add128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	lo = aLo + bLo
+	carry := uint64(0)
+	if lo < aLo {
+		carry = 1
+	}
+	hi = aHi + bHi + carry
+	return hi, lo
+}
+
+// sub128 subtracts b from a (both 128-bit unsigned), assuming a >= b.
+func sub128(aHi, aLo, bHi, bLo uint64) (hi, lo uint64) {
+	lo = aLo - bLo
+	borrow := uint64(0)
+	if aLo < bLo {
+		borrow = 1
+	}
+	hi = aHi - bHi - borrow
+	return hi, lo
+}
+
+// greater128 reports whether (aHi, aLo) > (bHi, bLo).
+func greater128(aHi, aLo, bHi, bLo uint64) bool {
+	if aHi != bHi {
+		return aHi > bHi
+	}
+	return aLo > bLo
+}
+
+// div128By divides the 128-bit value (hi, lo) by a uint64 divisor, returning the 128-bit
+// quotient. div is assumed non-zero.
+func div128By(hi, lo, div uint64) (qHi, qLo uint64) {
+	if div == 0 {
+		return hi, lo
+	}
+	qHi = hi / div
+	rem := hi % div
+	qLo, _ = div128(rem, lo, div)
+	return qHi, qLo
+}
+
+// isZero128 and isEven128 are small readability helpers for gcd128's loop conditions.
+func isZero128(hi, lo uint64) bool { return hi == 0 && lo == 0 }
+func isEven128(hi, lo uint64) bool { return lo&1 == 0 }
+
+// gcd128 computes the GCD of two 128-bit unsigned values via the binary (Stein's) algorithm,
+// which only needs shifts, comparisons, and subtraction - all of which are available at 128 bits
+// without a general 128-bit division primitive.
+func gcd128(aHi, aLo, bHi, bLo uint64) uint64 {
+	if isZero128(aHi, aLo) {
+		return fallback128To64(bHi, bLo)
+	}
+	if isZero128(bHi, bLo) {
+		return fallback128To64(aHi, aLo)
+	}
+
+	shift := uint(0)
+	for isEven128(aHi, aLo) && isEven128(bHi, bLo) {
+		aHi, aLo = shr128(aHi, aLo)
+		bHi, bLo = shr128(bHi, bLo)
+		shift++
+	}
+	for isEven128(aHi, aLo) {
+		aHi, aLo = shr128(aHi, aLo)
+	}
+
+	for !isZero128(bHi, bLo) {
+		for isEven128(bHi, bLo) {
+			bHi, bLo = shr128(bHi, bLo)
+		}
+		if greater128(aHi, aLo, bHi, bLo) {
+			aHi, aLo, bHi, bLo = bHi, bLo, aHi, aLo
+		}
+		bHi, bLo = sub128(bHi, bLo, aHi, aLo)
+	}
+
+	return fallback128To64(aHi, aLo) << shift //nolint:gosec // shift is bounded by the 128-bit width
+}
+
+// shr128 shifts a 128-bit value right by one bit.
+func shr128(hi, lo uint64) (uint64, uint64) {
+	lo = lo>>1 | hi<<63
+	hi >>= 1
+	return hi, lo
+}
+
+// fallback128To64 returns the low 64 bits of a 128-bit value that's expected to fit in 64 bits
+// (the GCD of two values bounded by int64/uint64 cross-products always does), clamping to
+// MaxUint64 in the pathological case it doesn't.
+func fallback128To64(hi, lo uint64) uint64 {
+	if hi != 0 {
+		return ^uint64(0)
+	}
+	return lo
+}
@@ -0,0 +1,41 @@
+package zerorat
+
+import "testing"
+
+func TestAddWideMatchesAddForSmallValues(t *testing.T) {
+	cases := []struct{ a, b Rat }{
+		{New(1, 3), New(1, 6)},
+		{New(-5, 7), New(2, 9)},
+		{New(1, 2), New(1, 2)},
+	}
+	for _, c := range cases {
+		want := c.a.Added(c.b)
+		got := c.a
+		got.AddWide(c.b)
+		if !got.Equal(want) {
+			t.Errorf("AddWide(%v, %v) = %v, want %v", c.a, c.b, got, want)
+		}
+	}
+}
+
+func TestAddWideSucceedsWhereAddOverflows(t *testing.T) {
+	// Denominators large enough that the cross-multiplication overflows int64/uint64 as raw
+	// products, but the GCD-reduced sum still fits.
+	a := New(1, 1<<62)
+	b := New(1, 1<<61)
+
+	overflowed := a.Added(b)
+	if overflowed.IsValid() {
+		t.Skip("fixture no longer overflows Add; adjust denominators")
+	}
+
+	wide := a
+	wide.AddWide(b)
+	if wide.IsInvalid() {
+		t.Fatal("AddWide still invalidated a sum that fits after reduction")
+	}
+	want := New(3, 1<<62) // 1/2^62 + 1/2^61 = 3/2^62
+	if !wide.Equal(want) {
+		t.Errorf("AddWide(1/2^62, 1/2^61) = %v, want %v", wide, want)
+	}
+}
@@ -0,0 +1,81 @@
+package zerorat
+
+// MulWide multiplies r by other the same as Mul, but widens the numerator and denominator
+// products to full 128 bits first via mul128, only invalidating once the GCD-reduced result
+// truly doesn't fit in int64/uint64. Unlike Mul, it skips the pre-multiplication cross-cancellation
+// fast path, so it rejects strictly fewer operand pairs than Mul at the cost of always doing the
+// 128-bit work.
+func (r *Rat) MulWide(other Rat) {
+	if r.IsInvalid() || other.IsInvalid() {
+		r.Invalidate()
+		return
+	}
+
+	selfNumAbs := absInt64ToUint64(r.numerator)
+	otherNumAbs := absInt64ToUint64(other.numerator)
+	numNeg := (r.numerator < 0) != (other.numerator < 0)
+
+	numHi, numLo := mul128(selfNumAbs, otherNumAbs)
+	denHi, denLo := mul128(r.denominator, other.denominator)
+
+	r.finishWideMulDiv(numHi, numLo, denHi, denLo, numNeg)
+}
+
+// DivWide divides r by other the same as Div, but widens the cross-multiplication to full 128
+// bits first; see MulWide.
+func (r *Rat) DivWide(other Rat) {
+	if r.IsInvalid() || other.IsInvalid() || other.numerator == 0 {
+		r.Invalidate()
+		return
+	}
+
+	selfNumAbs := absInt64ToUint64(r.numerator)
+	otherNumAbs := absInt64ToUint64(other.numerator)
+	numNeg := (r.numerator < 0) != (other.numerator < 0)
+
+	numHi, numLo := mul128(selfNumAbs, other.denominator)
+	denHi, denLo := mul128(r.denominator, otherNumAbs)
+
+	r.finishWideMulDiv(numHi, numLo, denHi, denLo, numNeg)
+}
+
+// finishWideMulDiv reduces a 128-bit (numerator, denominator) pair by their GCD and stores the
+// result in r, invalidating if either half still doesn't fit in 64 bits after reduction.
+func (r *Rat) finishWideMulDiv(numHi, numLo, denHi, denLo uint64, numNeg bool) {
+	if numHi == 0 && numLo == 0 {
+		r.numerator, r.denominator = 0, 1
+		return
+	}
+
+	g := gcd128(numHi, numLo, denHi, denLo)
+	numHi, numLo = div128By(numHi, numLo, g)
+	denHi, denLo = div128By(denHi, denLo, g)
+
+	if numHi != 0 || denHi != 0 {
+		r.Invalidate()
+		return
+	}
+
+	num, ok := uint64ToInt64WithSign(numLo, numNeg)
+	if !ok || denLo == 0 {
+		r.Invalidate()
+		return
+	}
+
+	r.numerator = num
+	r.denominator = denLo
+}
+
+// MultipliedWide returns r.MulWide(other) without modifying r (immutable operation).
+func (r Rat) MultipliedWide(other Rat) Rat {
+	result := r
+	result.MulWide(other)
+	return result
+}
+
+// DividedWide returns r.DivWide(other) without modifying r (immutable operation).
+func (r Rat) DividedWide(other Rat) Rat {
+	result := r
+	result.DivWide(other)
+	return result
+}
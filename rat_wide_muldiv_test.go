@@ -0,0 +1,37 @@
+package zerorat
+
+import "testing"
+
+func TestMulWideMatchesMulForSmallValues(t *testing.T) {
+	a := New(3, 4)
+	b := New(5, 7)
+
+	want := a.Multiplied(b)
+	got := a.MultipliedWide(b)
+
+	if !want.Equal(got) {
+		t.Errorf("MultipliedWide() = %v, want %v", got, want)
+	}
+}
+
+func TestDivWideMatchesDivForSmallValues(t *testing.T) {
+	a := New(3, 4)
+	b := New(5, 7)
+
+	want := a.Divided(b)
+	got := a.DividedWide(b)
+
+	if !want.Equal(got) {
+		t.Errorf("DividedWide() = %v, want %v", got, want)
+	}
+}
+
+func TestDivWideByZeroInvalidates(t *testing.T) {
+	a := New(1, 2)
+	zero := Zero()
+
+	got := a.DividedWide(zero)
+	if got.IsValid() {
+		t.Errorf("DividedWide by zero = %v, want invalid", got)
+	}
+}
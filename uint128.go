@@ -0,0 +1,57 @@
+package zerorat
+
+import (
+	"math/bits"
+	"strconv"
+)
+
+// Uint128 is an exact 128-bit unsigned integer, used to expose the intermediate cross-
+// multiplication product (numerator*denominator) that Rat computes internally via math/bits but
+// never surfaces, for callers who need to inspect it without overflow loss.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// MulUint64To128 multiplies a and b and returns the exact 128-bit product.
+func MulUint64To128(a, b uint64) Uint128 {
+	hi, lo := bits.Mul64(a, b)
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// Cross returns the 128-bit product r.numerator*other.denominator (as a signed magnitude: Neg
+// reports whether the true value is negative) and r.denominator*other.numerator, the two
+// cross-multiplication terms Compare uses to order two Rat values without reducing first.
+func (r Rat) Cross(other Rat) (left, right Uint128, leftNeg, rightNeg bool) {
+	leftNeg = r.numerator < 0
+	rightNeg = other.numerator < 0
+	left = MulUint64To128(absInt64ToUint64(r.numerator), other.denominator)
+	right = MulUint64To128(absInt64ToUint64(other.numerator), r.denominator)
+	return left, right, leftNeg, rightNeg
+}
+
+// Fits64 reports whether v fits in a uint64 (i.e. Hi is zero).
+func (v Uint128) Fits64() bool {
+	return v.Hi == 0
+}
+
+// String renders v in decimal, computing via repeated long division by 10 since Uint128 has no
+// native arithmetic beyond construction.
+func (v Uint128) String() string {
+	if v.Hi == 0 {
+		return strconv.FormatUint(v.Lo, 10)
+	}
+
+	digits := make([]byte, 0, 39)
+	hi, lo := v.Hi, v.Lo
+	for hi != 0 || lo != 0 {
+		qHi := hi / 10
+		rHi := hi % 10
+		qLo, digit := bits.Div64(rHi, lo, 10)
+		hi, lo = qHi, qLo
+		digits = append(digits, byte('0'+digit))
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return string(digits)
+}